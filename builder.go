@@ -0,0 +1,56 @@
+package sliceutils
+
+// Builder is used to efficiently build a slice with multiple appends. Capacity
+// can be reserved beforehand with Grow to avoid repeated reallocation. It is
+// similar to strings.Builder but for slices of any type.
+//
+// The zero value is an empty builder ready to use.
+type Builder[T any] struct {
+	buf []T
+}
+
+// Grows the capacity of the builder, if necessary, to guarantee space for
+// another `n` values. After Grow(n), at least `n` values can be appended
+// without another allocation.
+//
+// Panics if `n` is negative.
+func (b *Builder[T]) Grow(n int) {
+	if n < 0 {
+		panic("sliceutils: negative Builder.Grow count")
+	}
+	if cap(b.buf)-len(b.buf) < n {
+		buf := make([]T, len(b.buf), 2*cap(b.buf)+n)
+		copy(buf, b.buf)
+		b.buf = buf
+	}
+}
+
+// Appends values to the builder.
+func (b *Builder[T]) Append(values ...T) {
+	b.buf = append(b.buf, values...)
+}
+
+// Appends slice values to the builder.
+func (b *Builder[T]) AppendSlice(slice []T) {
+	b.buf = append(b.buf, slice...)
+}
+
+// Returns the number of values appended to the builder.
+func (b *Builder[T]) Len() int {
+	return len(b.buf)
+}
+
+// Resets the builder to be empty. Previously built slices are not modified.
+func (b *Builder[T]) Reset() {
+	b.buf = nil
+}
+
+// Returns the accumulated slice. The builder can still be appended to after
+// Build, which does not modify the previously built slices.
+//
+// Returns nil if nothing has been appended to or reserved in the builder.
+func (b *Builder[T]) Build() []T {
+	// Limit capacity so that appending to the returned slice cannot overwrite
+	// values appended to the builder later, and vice versa.
+	return b.buf[:len(b.buf):len(b.buf)]
+}
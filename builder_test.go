@@ -0,0 +1,68 @@
+package sliceutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder(t *testing.T) {
+	t.Run("Build slice from appends", func(t *testing.T) {
+		var builder Builder[int]
+		builder.Append(1, 2)
+		builder.AppendSlice([]int{3, 4})
+		builder.Append()
+		builder.AppendSlice(nil)
+
+		assert.Equal(t, 4, builder.Len())
+		assert.Equal(t, []int{1, 2, 3, 4}, builder.Build())
+	})
+
+	t.Run("Grow reserves capacity for appends", func(t *testing.T) {
+		var builder Builder[int]
+		builder.Append(1)
+		builder.Grow(100)
+		grown := &builder.buf[0]
+
+		// Exactly the reserved number of appends must not reallocate.
+		for i := 0; i < 100; i++ {
+			builder.Append(i)
+		}
+		assert.Same(t, grown, &builder.buf[0])
+		assert.Equal(t, 101, builder.Len())
+		assert.Equal(t, 1, builder.Build()[0])
+	})
+
+	t.Run("Built slice is not modified by later appends", func(t *testing.T) {
+		var builder Builder[string]
+		builder.Grow(10)
+		builder.Append("foo")
+		built := builder.Build()
+		builder.Append("bar")
+		built = append(built, "baz")
+
+		assert.Equal(t, []string{"foo", "baz"}, built)
+		assert.Equal(t, []string{"foo", "bar"}, builder.Build())
+	})
+
+	t.Run("Reset empties the builder", func(t *testing.T) {
+		var builder Builder[int]
+		builder.Append(1, 2)
+		built := builder.Build()
+		builder.Reset()
+		builder.Append(3)
+
+		assert.Equal(t, []int{1, 2}, built)
+		assert.Equal(t, []int{3}, builder.Build())
+	})
+
+	t.Run("Return nil on empty builder", func(t *testing.T) {
+		var builder Builder[int]
+		assert.Nil(t, builder.Build())
+	})
+
+	t.Run("Panic on negative grow", func(t *testing.T) {
+		var builder Builder[int]
+		assert.Panics(t, func() { builder.Grow(-1) })
+	})
+}
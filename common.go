@@ -1,5 +1,11 @@
 package sliceutils
 
+import (
+	"container/heap"
+	"runtime"
+	"sort"
+)
+
 // Creates a set out of slice elements. Duplicates are discarded.
 func makeSet[T comparable](slice []T) map[T]struct{} {
 	uniques := make(map[T]struct{})
@@ -48,3 +54,194 @@ func (sdg sliceDivGen) get(divIdx int) (int, int) {
 		return offset, sdg.minDivLen
 	}
 }
+
+// Clamps `n` between zero and `length`. Used to limit requested element counts
+// to the length of a slice.
+func clampLen(n, length int) int {
+	if n < 0 {
+		return 0
+	}
+	if n > length {
+		return length
+	}
+	return n
+}
+
+// Returns true if the value is a floating-point NaN, the only value which is
+// not equal to itself.
+func isNaN[T Ordered](val T) bool {
+	return val != val
+}
+
+// Returns true if left is less than right. Unlike `<`, defines a strict
+// ordering for floating-point values by ordering NaNs before all other values.
+func lessOrdered[T Ordered](lhs, rhs T) bool {
+	return (isNaN(lhs) && !isNaN(rhs)) || lhs < rhs
+}
+
+// Clamps a value between `lo` and `hi`, inclusive. Expects `lo <= hi`.
+func clampValue[T Ordered](val, lo, hi T) T {
+	if val < lo {
+		return lo
+	}
+	if val > hi {
+		return hi
+	}
+	return val
+}
+
+// Returns the index of the first occurrence of `sub` as a contiguous
+// subsequence of `slice`, or -1 if it does not occur. Empty `sub` occurs at
+// index zero.
+func indexOfSubslice[T comparable](slice, sub []T) int {
+outer:
+	for i := 0; i+len(sub) <= len(slice); i++ {
+		for j, val := range sub {
+			if slice[i+j] != val {
+				continue outer
+			}
+		}
+		return i
+	}
+	return -1
+}
+
+// Replaces at most `n` non-overlapping occurrences of `old` subsequence with
+// `new` in a copy of the slice. Negative `n` replaces all occurrences.
+//
+// Returns nil on nil slice.
+func replaceSubslice[T comparable](slice, old, new []T, n int) []T {
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	outSlice := make([]T, 0, len(slice))
+	// Empty `old` would match everywhere without advancing.
+	if len(old) == 0 {
+		return append(outSlice, slice...)
+	}
+	rest := slice
+	for n != 0 {
+		idx := indexOfSubslice(rest, old)
+		if idx < 0 {
+			break
+		}
+		outSlice = append(outSlice, rest[:idx]...)
+		outSlice = append(outSlice, new...)
+		rest = rest[idx+len(old):]
+		n--
+	}
+	return append(outSlice, rest...)
+}
+
+// Configuration of parallel functions.
+type parConfig struct {
+	// Number of goroutines the work is divided between.
+	workers int
+}
+
+// Creates a parallel function configuration by applying the options on top of
+// the defaults. Worker count is at least one.
+func newParConfig(opts []ParOption) parConfig {
+	config := parConfig{
+		workers: runtime.NumCPU(),
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	if config.workers < 1 {
+		config.workers = 1
+	}
+	return config
+}
+
+// Returns the number of divisions for a slice of given length. Number of
+// workers is limited by the length so that no goroutine is started without
+// elements, but at least one division is always returned.
+func (config parConfig) divsFor(length int) int {
+	if length < config.workers {
+		return MaxOf(length, 1)
+	}
+	return config.workers
+}
+
+// Returns the running results of combining slice elements left to right with
+// the combine function. The first result is the first element itself.
+//
+// Returns nil on nil slice.
+func scan[T any](slice []T, combineFn func(acc, val T) T) []T {
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	outSlice := make([]T, 0, len(slice))
+	for i, val := range slice {
+		if i > 0 {
+			val = combineFn(outSlice[i-1], val)
+		}
+		outSlice = append(outSlice, val)
+	}
+	return outSlice
+}
+
+// Element of a slice paired with its index for stable ordering.
+type indexedVal[T any] struct {
+	idx int
+	val T
+}
+
+// Heap of indexed values where the root is the last element in the order
+// defined by the comparison function and, for equal values, by index.
+type worstFirstHeap[T any] struct {
+	items  []indexedVal[T]
+	lessFn func(T, T) bool
+}
+
+func (h *worstFirstHeap[T]) Len() int { return len(h.items) }
+
+func (h *worstFirstHeap[T]) Less(i, j int) bool {
+	a, b := h.items[i], h.items[j]
+	if h.lessFn(b.val, a.val) {
+		return true
+	}
+	return !h.lessFn(a.val, b.val) && a.idx > b.idx
+}
+
+func (h *worstFirstHeap[T]) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+func (h *worstFirstHeap[T]) Push(x any) { h.items = append(h.items, x.(indexedVal[T])) }
+
+func (h *worstFirstHeap[T]) Pop() any {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
+
+// Selects the `n` first elements of the slice in the order defined by the
+// comparison function, as if the slice was stably sorted and then truncated.
+// Uses a bounded heap, so the time complexity is O(len(slice) * log(n)).
+//
+// Returns nil on nil slice.
+func selectFirstN[T any](slice []T, n int, lessFn func(T, T) bool) []T {
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	n = clampLen(n, len(slice))
+	if n == 0 {
+		return make([]T, 0)
+	}
+	h := &worstFirstHeap[T]{items: make([]indexedVal[T], 0, n), lessFn: lessFn}
+	for i, val := range slice {
+		if h.Len() < n {
+			heap.Push(h, indexedVal[T]{idx: i, val: val})
+		} else if lessFn(val, h.items[0].val) {
+			// Later index loses ties, so only strictly better values replace
+			// the root.
+			h.items[0] = indexedVal[T]{idx: i, val: val}
+			heap.Fix(h, 0)
+		}
+	}
+	sort.Slice(h.items, func(i, j int) bool { return h.Less(j, i) })
+	return Map(h.items, func(item indexedVal[T]) T { return item.val })
+}
@@ -1,6 +1,8 @@
 package sliceutils
 
 import (
+	"math"
+	"runtime"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -213,3 +215,136 @@ func TestSliceDivGen(t *testing.T) {
 		})
 	}
 }
+
+func TestClampLen(t *testing.T) {
+	t.Run("Keep value within bounds", func(t *testing.T) {
+		assert.Equal(t, 3, clampLen(3, 5))
+	})
+
+	t.Run("Clamp negative value to zero", func(t *testing.T) {
+		assert.Equal(t, 0, clampLen(-1, 5))
+	})
+
+	t.Run("Clamp too large value to length", func(t *testing.T) {
+		assert.Equal(t, 5, clampLen(7, 5))
+	})
+}
+
+func TestLessOrdered(t *testing.T) {
+	nan := math.NaN()
+
+	t.Run("Compare ordinary values", func(t *testing.T) {
+		assert.True(t, lessOrdered(1, 2))
+		assert.False(t, lessOrdered(2, 1))
+		assert.False(t, lessOrdered("a", "a"))
+	})
+
+	t.Run("Order NaN before other values", func(t *testing.T) {
+		assert.True(t, lessOrdered(nan, math.Inf(-1)))
+		assert.False(t, lessOrdered(math.Inf(-1), nan))
+		assert.False(t, lessOrdered(nan, nan))
+	})
+}
+
+func TestClampValue(t *testing.T) {
+	t.Run("Keep value within bounds", func(t *testing.T) {
+		assert.Equal(t, 3, clampValue(3, 1, 5))
+	})
+
+	t.Run("Clamp value below and above bounds", func(t *testing.T) {
+		assert.Equal(t, 1, clampValue(-2, 1, 5))
+		assert.Equal(t, 5, clampValue(9, 1, 5))
+	})
+}
+
+func TestIndexOfSubslice(t *testing.T) {
+	t.Run("Find subslice in the middle", func(t *testing.T) {
+		assert.Equal(t, 2, indexOfSubslice([]int{1, 2, 3, 4, 3, 4}, []int{3, 4}))
+	})
+
+	t.Run("Find subslice at the end", func(t *testing.T) {
+		assert.Equal(t, 3, indexOfSubslice([]int{1, 2, 1, 2, 3}, []int{2, 3}))
+	})
+
+	t.Run("Return -1 when not found", func(t *testing.T) {
+		assert.Equal(t, -1, indexOfSubslice([]int{1, 2, 3}, []int{3, 4}))
+	})
+
+	t.Run("Return -1 when subslice is longer", func(t *testing.T) {
+		assert.Equal(t, -1, indexOfSubslice([]int{1}, []int{1, 2}))
+	})
+
+	t.Run("Empty subslice is found at zero", func(t *testing.T) {
+		assert.Equal(t, 0, indexOfSubslice([]int{1, 2}, []int{}))
+	})
+}
+
+func TestNewParConfig(t *testing.T) {
+	t.Run("Default to number of logical processors", func(t *testing.T) {
+		config := newParConfig(nil)
+		assert.Equal(t, runtime.NumCPU(), config.workers)
+	})
+
+	t.Run("Set number of workers", func(t *testing.T) {
+		config := newParConfig([]ParOption{WithWorkers(3)})
+		assert.Equal(t, 3, config.workers)
+	})
+
+	t.Run("Last option takes effect", func(t *testing.T) {
+		config := newParConfig([]ParOption{WithWorkers(3), WithWorkers(5)})
+		assert.Equal(t, 5, config.workers)
+	})
+
+	t.Run("Fall back to one worker below one", func(t *testing.T) {
+		assert.Equal(t, 1, newParConfig([]ParOption{WithWorkers(0)}).workers)
+		assert.Equal(t, 1, newParConfig([]ParOption{WithWorkers(-2)}).workers)
+	})
+}
+
+func TestScan(t *testing.T) {
+	concat := func(acc, val string) string { return acc + val }
+
+	t.Run("Return running results", func(t *testing.T) {
+		assert.Equal(t, []string{"a", "ab", "abc"}, scan([]string{"a", "b", "c"}, concat))
+	})
+
+	t.Run("Return empty slice on empty slice", func(t *testing.T) {
+		assert.Equal(t, []string{}, scan([]string{}, concat))
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		assert.Nil(t, scan(nil, concat))
+	})
+}
+
+func TestSelectFirstN(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	t.Run("Match stable sort and truncate", func(t *testing.T) {
+		slice := Generate(200, func(idx int) int { return (idx * 7919) % 31 })
+		for _, n := range []int{0, 1, 5, 31, 199, 200} {
+			assert.Equal(t, SortBy(slice, less)[:n], selectFirstN(slice, n, less))
+		}
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		assert.Nil(t, selectFirstN(nil, 3, less))
+	})
+}
+
+func TestParConfigDivsFor(t *testing.T) {
+	t.Run("Use number of workers for long slices", func(t *testing.T) {
+		config := newParConfig([]ParOption{WithWorkers(4)})
+		assert.Equal(t, 4, config.divsFor(100))
+	})
+
+	t.Run("Limit to slice length", func(t *testing.T) {
+		config := newParConfig([]ParOption{WithWorkers(1_000_000)})
+		assert.Equal(t, 3, config.divsFor(3))
+	})
+
+	t.Run("Return at least one division", func(t *testing.T) {
+		config := newParConfig([]ParOption{WithWorkers(4)})
+		assert.Equal(t, 1, config.divsFor(0))
+	})
+}
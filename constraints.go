@@ -0,0 +1,27 @@
+package sliceutils
+
+// Ordered is a constraint for types which support the ordering operators
+// `<`, `<=`, `>` and `>=`. Equivalent to `cmp.Ordered` of newer Go versions.
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
+}
+
+// Integer is a constraint for signed and unsigned integer types.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float is a constraint for floating-point types.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number is a constraint for types which support the arithmetic operators
+// `+`, `-`, `*` and `/`.
+type Number interface {
+	Integer | Float
+}
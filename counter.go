@@ -0,0 +1,92 @@
+package sliceutils
+
+import "sort"
+
+// Counter counts the occurrences of values incrementally. It computes the same
+// counts as Frequencies, but values can be added one by one, e.g. when reading
+// them from a channel or from a stream which does not fit in memory.
+//
+// The zero value is an empty counter ready to use.
+//
+// Counter is not safe for concurrent use. Concurrent writes, or writes
+// concurrent with reads, must be synchronized by the caller.
+type Counter[T comparable] struct {
+	// Number of occurrences per value.
+	counts map[T]int
+	// Distinct values in the order of their first occurrence.
+	order []T
+	// Total number of added values.
+	total int
+}
+
+// Adds a single value to the counter.
+func (c *Counter[T]) Add(value T) {
+	// Lazily initialize to make the zero value usable.
+	if c.counts == nil {
+		c.counts = make(map[T]int)
+	}
+	count, exists := c.counts[value]
+	if !exists {
+		c.order = append(c.order, value)
+	}
+	c.counts[value] = count + 1
+	c.total++
+}
+
+// Adds all slice values to the counter.
+//
+// Does nothing on nil slice.
+func (c *Counter[T]) AddAll(slice []T) {
+	for _, val := range slice {
+		c.Add(val)
+	}
+}
+
+// Returns the number of times given value has been added to the counter.
+//
+// Returns zero for values which have not been added.
+func (c *Counter[T]) Count(value T) int {
+	// Missing value returns default which is zero.
+	return c.counts[value]
+}
+
+// Returns the total number of values added to the counter.
+func (c *Counter[T]) Total() int {
+	return c.total
+}
+
+// Returns the `n` most common values paired with their counts in descending
+// order of count. Values with equal counts are ordered by their first
+// occurrence.
+//
+// `n` is clamped between zero and the number of distinct values.
+func (c *Counter[T]) MostCommon(n int) []Pair[T, int] {
+	pairs := c.pairs()
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return pairs[i].Second > pairs[j].Second
+	})
+	return pairs[:clampLen(n, len(pairs))]
+}
+
+// Returns the `n` least common values paired with their counts in ascending
+// order of count. Values with equal counts are ordered by their first
+// occurrence.
+//
+// `n` is clamped between zero and the number of distinct values.
+func (c *Counter[T]) LeastCommon(n int) []Pair[T, int] {
+	pairs := c.pairs()
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return pairs[i].Second < pairs[j].Second
+	})
+	return pairs[:clampLen(n, len(pairs))]
+}
+
+// Returns the distinct values paired with their counts in the order of their
+// first occurrence.
+func (c *Counter[T]) pairs() []Pair[T, int] {
+	pairs := make([]Pair[T, int], 0, len(c.order))
+	for _, val := range c.order {
+		pairs = append(pairs, Pair[T, int]{First: val, Second: c.counts[val]})
+	}
+	return pairs
+}
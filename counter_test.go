@@ -0,0 +1,120 @@
+package sliceutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounter(t *testing.T) {
+	t.Run("Count values added one by one", func(t *testing.T) {
+		var counter Counter[string]
+		counter.Add("foo")
+		counter.Add("bar")
+		counter.Add("foo")
+
+		assert.Equal(t, 2, counter.Count("foo"))
+		assert.Equal(t, 1, counter.Count("bar"))
+		assert.Equal(t, 3, counter.Total())
+	})
+
+	t.Run("Count values added as slices", func(t *testing.T) {
+		var counter Counter[int]
+		counter.AddAll([]int{1, 2, 2})
+		counter.AddAll([]int{3, 2})
+
+		assert.Equal(t, 1, counter.Count(1))
+		assert.Equal(t, 3, counter.Count(2))
+		assert.Equal(t, 1, counter.Count(3))
+		assert.Equal(t, 5, counter.Total())
+	})
+
+	t.Run("Match counts of Frequencies", func(t *testing.T) {
+		slice := []int{1, 5, 2, 5, 1, 1, 7}
+		var counter Counter[int]
+		counter.AddAll(slice)
+
+		for val, count := range Frequencies(slice) {
+			assert.Equal(t, count, counter.Count(val))
+		}
+	})
+
+	t.Run("Return zero for values not added", func(t *testing.T) {
+		var counter Counter[int]
+		assert.Equal(t, 0, counter.Count(1))
+		assert.Equal(t, 0, counter.Total())
+	})
+
+	t.Run("Do nothing on nil slice", func(t *testing.T) {
+		var counter Counter[int]
+		counter.AddAll(nil)
+		assert.Equal(t, 0, counter.Total())
+	})
+}
+
+func TestCounterMostCommon(t *testing.T) {
+	t.Run("Return most common values in descending order", func(t *testing.T) {
+		var counter Counter[string]
+		counter.AddAll([]string{"a", "b", "c", "b", "c", "c"})
+
+		assert.Equal(t, []Pair[string, int]{
+			{First: "c", Second: 3},
+			{First: "b", Second: 2},
+		}, counter.MostCommon(2))
+	})
+
+	t.Run("Order ties by first occurrence", func(t *testing.T) {
+		var counter Counter[string]
+		counter.AddAll([]string{"b", "a", "c", "a", "b"})
+
+		assert.Equal(t, []Pair[string, int]{
+			{First: "b", Second: 2},
+			{First: "a", Second: 2},
+			{First: "c", Second: 1},
+		}, counter.MostCommon(3))
+	})
+
+	t.Run("Clamp n to the number of distinct values", func(t *testing.T) {
+		var counter Counter[int]
+		counter.AddAll([]int{1, 1, 2})
+
+		assert.Equal(t, []Pair[int, int]{
+			{First: 1, Second: 2},
+			{First: 2, Second: 1},
+		}, counter.MostCommon(10))
+		assert.Equal(t, []Pair[int, int]{}, counter.MostCommon(-1))
+	})
+
+	t.Run("Return empty slice on empty counter", func(t *testing.T) {
+		var counter Counter[int]
+		assert.Equal(t, []Pair[int, int]{}, counter.MostCommon(3))
+	})
+}
+
+func TestCounterLeastCommon(t *testing.T) {
+	t.Run("Return least common values in ascending order", func(t *testing.T) {
+		var counter Counter[string]
+		counter.AddAll([]string{"a", "b", "c", "b", "c", "c"})
+
+		assert.Equal(t, []Pair[string, int]{
+			{First: "a", Second: 1},
+			{First: "b", Second: 2},
+		}, counter.LeastCommon(2))
+	})
+
+	t.Run("Order ties by first occurrence", func(t *testing.T) {
+		var counter Counter[string]
+		counter.AddAll([]string{"b", "a", "c", "a", "b"})
+
+		assert.Equal(t, []Pair[string, int]{
+			{First: "c", Second: 1},
+			{First: "b", Second: 2},
+			{First: "a", Second: 2},
+		}, counter.LeastCommon(3))
+	})
+
+	t.Run("Return empty slice on empty counter", func(t *testing.T) {
+		var counter Counter[int]
+		assert.Equal(t, []Pair[int, int]{}, counter.LeastCommon(3))
+	})
+}
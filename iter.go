@@ -0,0 +1,94 @@
+//go:build go1.23
+
+package sliceutils
+
+import "iter"
+
+// Collects the values of a sequence into a newly allocated slice in the order
+// they are yielded.
+//
+// Returns empty slice on empty sequence. Panics on nil sequence.
+func Collect[T any](seq iter.Seq[T]) []T {
+	outSlice := make([]T, 0)
+	for val := range seq {
+		outSlice = append(outSlice, val)
+	}
+	return outSlice
+}
+
+// Collects the key-value pairs of a sequence into a newly allocated map. If a
+// key is yielded multiple times, the last value is kept.
+//
+// Returns empty map on empty sequence. Panics on nil sequence.
+func Collect2[K comparable, V any](seq iter.Seq2[K, V]) map[K]V {
+	outMap := make(map[K]V)
+	for key, val := range seq {
+		outMap[key] = val
+	}
+	return outMap
+}
+
+// Returns a sequence yielding the index and value of each slice element in
+// order. Elements are read lazily, so modifications to the slice made during
+// iteration are visible.
+//
+// Returns empty sequence on nil slice.
+func Enumerate[T any](slice []T) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, val := range slice {
+			if !yield(i, val) {
+				return
+			}
+		}
+	}
+}
+
+// Returns a sequence yielding the values of `seq` which satisfy the predicate
+// function. Sequence is lazy; nothing is evaluated until it is iterated, and
+// stopping the iteration early also stops iterating `seq`.
+//
+// Panics on nil predicate function when the sequence is iterated.
+func FilterSeq[T any](seq iter.Seq[T], predFn func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if predFn == nil {
+			panic("sliceutils: nil FilterSeq predicate function")
+		}
+		for val := range seq {
+			if predFn(val) && !yield(val) {
+				return
+			}
+		}
+	}
+}
+
+// Returns a sequence yielding the values of `seq` mapped through the mapping
+// function. Sequence is lazy; nothing is evaluated until it is iterated, and
+// stopping the iteration early also stops iterating `seq`.
+//
+// Panics on nil mapping function when the sequence is iterated.
+func MapSeq[T, U any](seq iter.Seq[T], fn func(T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		if fn == nil {
+			panic("sliceutils: nil MapSeq mapping function")
+		}
+		for val := range seq {
+			if !yield(fn(val)) {
+				return
+			}
+		}
+	}
+}
+
+// Returns a sequence yielding each slice element in order. Elements are read
+// lazily, so modifications to the slice made during iteration are visible.
+//
+// Returns empty sequence on nil slice.
+func Values[T any](slice []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, val := range slice {
+			if !yield(val) {
+				return
+			}
+		}
+	}
+}
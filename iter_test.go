@@ -0,0 +1,168 @@
+//go:build go1.23
+
+package sliceutils
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollect(t *testing.T) {
+	t.Run("Collect finite sequence", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2, 3}, Collect(Values([]int{1, 2, 3})))
+	})
+
+	t.Run("Collect into new slice", func(t *testing.T) {
+		slice := []int{1, 2}
+		collected := Collect(Values(slice))
+		collected[0] = 9
+		assert.Equal(t, []int{1, 2}, slice)
+	})
+
+	t.Run("Return empty slice on empty sequence", func(t *testing.T) {
+		assert.Equal(t, []int{}, Collect(Values[int](nil)))
+	})
+}
+
+func TestCollect2(t *testing.T) {
+	t.Run("Collect finite sequence", func(t *testing.T) {
+		collected := Collect2(Enumerate([]string{"a", "b"}))
+		assert.Equal(t, map[int]string{0: "a", 1: "b"}, collected)
+	})
+
+	t.Run("Later keys overwrite earlier ones", func(t *testing.T) {
+		seq := func(yield func(string, int) bool) {
+			_ = yield("a", 1) && yield("b", 2) && yield("a", 3)
+		}
+		assert.Equal(t, map[string]int{"a": 3, "b": 2}, Collect2(seq))
+	})
+
+	t.Run("Return empty map on empty sequence", func(t *testing.T) {
+		assert.Equal(t, map[int]string{}, Collect2(Enumerate[string](nil)))
+	})
+}
+
+func TestEnumerate(t *testing.T) {
+	t.Run("Yield indices and values in order", func(t *testing.T) {
+		indices := []int{}
+		values := []string{}
+		for i, val := range Enumerate([]string{"a", "b", "c"}) {
+			indices = append(indices, i)
+			values = append(values, val)
+		}
+		assert.Equal(t, []int{0, 1, 2}, indices)
+		assert.Equal(t, []string{"a", "b", "c"}, values)
+	})
+
+	t.Run("Stop on early break", func(t *testing.T) {
+		visited := 0
+		for i := range Enumerate([]int{1, 2, 3, 4}) {
+			visited++
+			if i == 1 {
+				break
+			}
+		}
+		assert.Equal(t, 2, visited)
+	})
+
+	t.Run("Yield nothing on nil slice", func(t *testing.T) {
+		for range Enumerate[int](nil) {
+			assert.Fail(t, "nil slice yielded a value")
+		}
+	})
+}
+
+func TestFilterSeq(t *testing.T) {
+	isEven := func(i int) bool { return i%2 == 0 }
+
+	t.Run("Yield values satisfying predicate", func(t *testing.T) {
+		filtered := FilterSeq(Values([]int{1, 2, 3, 4, 6}), isEven)
+		assert.Equal(t, []int{2, 4, 6}, Collect(filtered))
+	})
+
+	t.Run("Do not evaluate before iteration", func(t *testing.T) {
+		calls := 0
+		filtered := FilterSeq(Values([]int{1, 2, 3}), func(i int) bool { calls++; return true })
+		assert.Equal(t, 0, calls)
+		Collect(filtered)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("Stop upstream on early break", func(t *testing.T) {
+		pulled := 0
+		upstream := MapSeq(Values([]int{1, 2, 3, 4, 5, 6}), func(i int) int { pulled++; return i })
+		for val := range FilterSeq(upstream, isEven) {
+			if val == 4 {
+				break
+			}
+		}
+		assert.Equal(t, 4, pulled)
+	})
+
+	t.Run("Panic on nil predicate function when iterated", func(t *testing.T) {
+		filtered := FilterSeq[int](Values[int](nil), nil)
+		assert.PanicsWithValue(t, "sliceutils: nil FilterSeq predicate function", func() { Collect(filtered) })
+	})
+}
+
+func TestMapSeq(t *testing.T) {
+	double := func(i int) int { return 2 * i }
+
+	t.Run("Yield mapped values", func(t *testing.T) {
+		mapped := MapSeq(Values([]int{1, 2, 3}), func(i int) string { return strconv.Itoa(i) })
+		assert.Equal(t, []string{"1", "2", "3"}, Collect(mapped))
+	})
+
+	t.Run("Do not evaluate before iteration", func(t *testing.T) {
+		calls := 0
+		mapped := MapSeq(Values([]int{1, 2, 3}), func(i int) int { calls++; return i })
+		assert.Equal(t, 0, calls)
+		Collect(mapped)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("Stop upstream on early break", func(t *testing.T) {
+		calls := 0
+		mapped := MapSeq(Values([]int{1, 2, 3, 4}), func(i int) int { calls++; return double(i) })
+		for val := range mapped {
+			if val == 4 {
+				break
+			}
+		}
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("Panic on nil mapping function when iterated", func(t *testing.T) {
+		mapped := MapSeq[int, int](Values[int](nil), nil)
+		assert.PanicsWithValue(t, "sliceutils: nil MapSeq mapping function", func() { Collect(mapped) })
+	})
+}
+
+func TestValues(t *testing.T) {
+	t.Run("Yield values in order", func(t *testing.T) {
+		values := []int{}
+		for val := range Values([]int{3, 1, 2}) {
+			values = append(values, val)
+		}
+		assert.Equal(t, []int{3, 1, 2}, values)
+	})
+
+	t.Run("Stop on early break", func(t *testing.T) {
+		values := []int{}
+		for val := range Values([]int{1, 2, 3, 4}) {
+			if val == 3 {
+				break
+			}
+			values = append(values, val)
+		}
+		assert.Equal(t, []int{1, 2}, values)
+	})
+
+	t.Run("Yield nothing on nil slice", func(t *testing.T) {
+		for range Values[int](nil) {
+			assert.Fail(t, "nil slice yielded a value")
+		}
+	})
+}
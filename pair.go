@@ -0,0 +1,9 @@
+package sliceutils
+
+// Pair holds two values of possibly different types. It is used by functions
+// which need to return two associated values per element, e.g. a value and its
+// count.
+type Pair[T, U any] struct {
+	First  T
+	Second U
+}
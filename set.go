@@ -0,0 +1,87 @@
+package sliceutils
+
+// Set is an unordered collection of unique values backed by a map. It is the
+// reusable counterpart of the slice set functions such as Union and
+// Intersection.
+//
+// Set must be created with NewSet or make before adding values; a nil set can
+// only be read. Set is not safe for concurrent use.
+type Set[T comparable] map[T]struct{}
+
+// Creates a set containing given elements. Duplicate elements are stored once.
+func NewSet[T comparable](elems ...T) Set[T] {
+	return Set[T](makeSet(elems))
+}
+
+// Adds a value to the set. Adding an existing value does nothing.
+func (s Set[T]) Add(value T) {
+	s[value] = struct{}{}
+}
+
+// Removes a value from the set. Removing a missing value does nothing.
+func (s Set[T]) Remove(value T) {
+	delete(s, value)
+}
+
+// Returns true if the set contains given value.
+func (s Set[T]) Contains(value T) bool {
+	_, exists := s[value]
+	return exists
+}
+
+// Returns the number of values in the set.
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// Creates a new set containing values from both sets.
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	outSet := make(Set[T], len(s)+len(other))
+	for val := range s {
+		outSet[val] = struct{}{}
+	}
+	for val := range other {
+		outSet[val] = struct{}{}
+	}
+	return outSet
+}
+
+// Creates a new set containing values which are in both sets.
+func (s Set[T]) Intersection(other Set[T]) Set[T] {
+	// Iterate over the smaller set.
+	small, large := s, other
+	if len(large) < len(small) {
+		small, large = large, small
+	}
+	outSet := make(Set[T])
+	for val := range small {
+		if large.Contains(val) {
+			outSet[val] = struct{}{}
+		}
+	}
+	return outSet
+}
+
+// Creates a new set containing values of this set which are not in the other
+// set.
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	outSet := make(Set[T])
+	for val := range s {
+		if !other.Contains(val) {
+			outSet[val] = struct{}{}
+		}
+	}
+	return outSet
+}
+
+// Returns the values of the set as a slice. Order of the values is
+// unspecified and may differ between calls.
+//
+// Returns empty slice on empty or nil set.
+func (s Set[T]) ToSlice() []T {
+	outSlice := make([]T, 0, len(s))
+	for val := range s {
+		outSlice = append(outSlice, val)
+	}
+	return outSlice
+}
@@ -0,0 +1,132 @@
+package sliceutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSet(t *testing.T) {
+	t.Run("Create set from elements with duplicates", func(t *testing.T) {
+		set := NewSet(1, 2, 2, 3)
+		assert.Equal(t, Set[int]{1: {}, 2: {}, 3: {}}, set)
+	})
+
+	t.Run("Create empty set on no elements", func(t *testing.T) {
+		set := NewSet[int]()
+		assert.NotNil(t, set)
+		assert.Equal(t, 0, set.Len())
+	})
+}
+
+func TestSetAdd(t *testing.T) {
+	t.Run("Add new and existing values", func(t *testing.T) {
+		set := NewSet[string]()
+		set.Add("foo")
+		set.Add("bar")
+		set.Add("foo")
+		assert.Equal(t, 2, set.Len())
+		assert.True(t, set.Contains("foo"))
+		assert.True(t, set.Contains("bar"))
+	})
+}
+
+func TestSetRemove(t *testing.T) {
+	t.Run("Remove existing value", func(t *testing.T) {
+		set := NewSet(1, 2, 3)
+		set.Remove(2)
+		assert.Equal(t, NewSet(1, 3), set)
+	})
+
+	t.Run("Remove missing value", func(t *testing.T) {
+		set := NewSet(1)
+		set.Remove(2)
+		assert.Equal(t, NewSet(1), set)
+	})
+}
+
+func TestSetContains(t *testing.T) {
+	t.Run("Contains added values only", func(t *testing.T) {
+		set := NewSet(1, 2)
+		assert.True(t, set.Contains(1))
+		assert.False(t, set.Contains(3))
+	})
+
+	t.Run("Nil set contains nothing", func(t *testing.T) {
+		var set Set[int]
+		assert.False(t, set.Contains(0))
+	})
+}
+
+func TestSetLen(t *testing.T) {
+	t.Run("Count unique values", func(t *testing.T) {
+		assert.Equal(t, 3, NewSet(1, 1, 2, 3, 3).Len())
+	})
+
+	t.Run("Nil set is empty", func(t *testing.T) {
+		var set Set[int]
+		assert.Equal(t, 0, set.Len())
+	})
+}
+
+func TestSetUnion(t *testing.T) {
+	t.Run("Union of two overlapping sets", func(t *testing.T) {
+		a := NewSet(1, 2, 3)
+		b := NewSet(3, 4)
+		assert.Equal(t, NewSet(1, 2, 3, 4), a.Union(b))
+	})
+
+	t.Run("Do not modify operands", func(t *testing.T) {
+		a := NewSet(1)
+		b := NewSet(2)
+		a.Union(b)
+		assert.Equal(t, NewSet(1), a)
+		assert.Equal(t, NewSet(2), b)
+	})
+
+	t.Run("Union with nil set", func(t *testing.T) {
+		var b Set[int]
+		assert.Equal(t, NewSet(1, 2), NewSet(1, 2).Union(b))
+	})
+}
+
+func TestSetIntersection(t *testing.T) {
+	t.Run("Intersection of two overlapping sets", func(t *testing.T) {
+		a := NewSet(1, 2, 3, 4)
+		b := NewSet(4, 2, 6)
+		assert.Equal(t, NewSet(2, 4), a.Intersection(b))
+		assert.Equal(t, NewSet(2, 4), b.Intersection(a))
+	})
+
+	t.Run("Intersection of two non-overlapping sets", func(t *testing.T) {
+		a := NewSet(1, 2)
+		b := NewSet(3)
+		assert.Equal(t, NewSet[int](), a.Intersection(b))
+	})
+}
+
+func TestSetDifference(t *testing.T) {
+	t.Run("Difference of two overlapping sets", func(t *testing.T) {
+		a := NewSet(1, 2, 3)
+		b := NewSet(3, 2, 6)
+		assert.Equal(t, NewSet(1), a.Difference(b))
+		assert.Equal(t, NewSet(6), b.Difference(a))
+	})
+
+	t.Run("Difference with nil set", func(t *testing.T) {
+		var b Set[int]
+		assert.Equal(t, NewSet(1, 2), NewSet(1, 2).Difference(b))
+	})
+}
+
+func TestSetToSlice(t *testing.T) {
+	t.Run("Return all values", func(t *testing.T) {
+		slice := NewSet(3, 1, 2).ToSlice()
+		assert.ElementsMatch(t, []int{1, 2, 3}, slice)
+	})
+
+	t.Run("Return empty slice on nil set", func(t *testing.T) {
+		var set Set[int]
+		assert.Equal(t, []int{}, set.ToSlice())
+	})
+}
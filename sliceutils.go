@@ -1,10 +1,20 @@
 package sliceutils
 
 import (
-	"runtime"
+	"context"
+	"math/rand"
+	"sort"
 	"sync"
 )
 
+// Adds elements of two slices together by their indices up to the length of
+// the shorter slice.
+//
+// Returns nil if either slice is nil.
+func Add[T Number](lhs, rhs []T) []T {
+	return ZipWith(lhs, rhs, func(a, b T) T { return a + b })
+}
+
 // Returns true if all slice elements are evaluated true with given evaluator
 // function.
 //
@@ -41,6 +51,257 @@ func AreDisjoint[T comparable](lhs, rhs []T) bool {
 	})
 }
 
+// Returns the index of the maximum element and true from non-empty slice using
+// the provided comparison function. To get maximum value, pass a comparison
+// function which returns true when left is less than right. Function is
+// stable, i.e. returns the index of the first occurrence of maximum value.
+//
+// If slice is empty, returns zero and false.
+func ArgMax[T any](slice []T, lessFn func(T, T) bool) (int, bool) {
+	if len(slice) == 0 {
+		return 0, false
+	}
+	maxIdx := 0
+	for i := 1; i < len(slice); i++ {
+		if lessFn(slice[maxIdx], slice[i]) {
+			maxIdx = i
+		}
+	}
+	return maxIdx, true
+}
+
+// Returns the index of the minimum element and true from non-empty slice using
+// the provided comparison function. To get minimum value, pass a comparison
+// function which returns true when left is less than right. Function is
+// stable, i.e. returns the index of the first occurrence of minimum value.
+//
+// If slice is empty, returns zero and false.
+func ArgMin[T any](slice []T, lessFn func(T, T) bool) (int, bool) {
+	if len(slice) == 0 {
+		return 0, false
+	}
+	minIdx := 0
+	for i := 1; i < len(slice); i++ {
+		if lessFn(slice[i], slice[minIdx]) {
+			minIdx = i
+		}
+	}
+	return minIdx, true
+}
+
+// Returns the indices which would sort the slice by given comparison function.
+// For ascending order, pass a comparison function which returns true when left
+// is less than right. Sort is stable and the slice is not modified. Resulting
+// permutation can be used to reorder other slices aligned with this slice.
+//
+// Returns nil on nil slice. Panics on nil comparison function.
+func ArgSortBy[T any](slice []T, lessFn func(T, T) bool) []int {
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	indices := Generate(len(slice), func(idx int) int { return idx })
+	sort.SliceStable(indices, func(i, j int) bool {
+		return lessFn(slice[indices[i]], slice[indices[j]])
+	})
+	return indices
+}
+
+// Creates a map from slice values. The associate function returns a key and a
+// value for each slice value. On duplicate keys, later slice values overwrite
+// earlier ones.
+//
+// Returns nil on nil slice. Panics on nil associate function.
+func Associate[T any, K comparable, V any](slice []T, fn func(T) (K, V)) map[K]V {
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	outMap := make(map[K]V, len(slice))
+	for _, val := range slice {
+		key, value := fn(val)
+		outMap[key] = value
+	}
+	return outMap
+}
+
+// Creates a map from slice values using keys returned by the key function. On
+// duplicate keys, later slice values overwrite earlier ones.
+//
+// Returns nil on nil slice. Panics on nil key function.
+func AssociateBy[T any, K comparable](slice []T, keyFn func(T) K) map[K]T {
+	return Associate(slice, func(val T) (K, T) { return keyFn(val), val })
+}
+
+// Returns the arithmetic mean of slice elements as float64 and true. Elements
+// are converted to float64 before summing, so the mean of integers is not
+// truncated.
+//
+// Returns zero and false on empty or nil slice.
+func Average[T Number](slice []T) (float64, bool) {
+	if len(slice) == 0 {
+		return 0, false
+	}
+	sum := Fold(slice, 0.0, func(acc float64, val T) float64 {
+		return acc + float64(val)
+	})
+	return sum / float64(len(slice)), true
+}
+
+// Searches for target in a slice sorted by given comparison function. The
+// comparison function returns a negative number when its left argument is
+// less than the right argument, zero when they are equal, and a positive number
+// otherwise. Returns the index where target is found and true, or the index
+// where target would be inserted to keep the slice sorted and false. If there
+// are multiple equal elements, returns the index of the first one.
+//
+// Result is undefined if the slice is not sorted by the comparison function.
+// Returns zero and false on nil slice. Panics on nil comparison function.
+func BinarySearchBy[T any](slice []T, target T, cmpFn func(T, T) int) (int, bool) {
+	lo, hi := 0, len(slice)
+	for lo < hi {
+		// Avoid overflow when computing the midpoint.
+		mid := int(uint(lo+hi) >> 1)
+		if cmpFn(slice[mid], target) < 0 {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, lo < len(slice) && cmpFn(slice[lo], target) == 0
+}
+
+// Returns the `n` smallest elements of the slice in ascending order using given
+// comparison function, which should return true when left is less than right.
+// Equal elements keep their original order. Selection uses a bounded heap, so
+// the time complexity is O(len(slice) * log(n)) instead of sorting the whole
+// slice.
+//
+// Returns all elements in ascending order if `n` is at least the length of the
+// slice and empty slice if `n` is not positive. Returns nil on nil slice.
+// Panics on nil comparison function.
+func BottomN[T any](slice []T, n int, lessFn func(T, T) bool) []T {
+	return selectFirstN(slice, n, lessFn)
+}
+
+// Groups consecutive elements sharing the same key into chunks. A new chunk is
+// started whenever the key returned by the key function differs from the key
+// of the previous element. Unlike GroupBy, elements with equal keys are only
+// grouped together if they are adjacent. Chunks share the backing array of
+// the original slice.
+//
+// This is an alias of SplitByKeyChange.
+//
+// Returns nil on nil slice. Panics on nil key function.
+func ChunkBy[T any, K comparable](slice []T, keyFn func(T) K) [][]T {
+	return SplitByKeyChange(slice, keyFn)
+}
+
+// Splits a slice into consecutive groups and reduces each group into a single
+// value. A new group is started whenever the split function returns true for
+// the previous and the current element. Each group is reduced starting from
+// the initial value `init` with the reduce function.
+//
+// Returns nil on nil slice. Panics on nil split or reduce function.
+func ChunkReduce[T, U any](slice []T, init U, shouldSplit func(prev, cur T) bool, reduceFn func(U, T) U) []U {
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	outSlice := make([]U, 0)
+	if len(slice) == 0 {
+		return outSlice
+	}
+	acc := reduceFn(init, slice[0])
+	for i := 1; i < len(slice); i++ {
+		if shouldSplit(slice[i-1], slice[i]) {
+			outSlice = append(outSlice, acc)
+			acc = init
+		}
+		acc = reduceFn(acc, slice[i])
+	}
+	return append(outSlice, acc)
+}
+
+// Creates a copy of the slice where each element is clamped between `lo` and
+// `hi`, inclusive.
+//
+// Returns nil on nil slice. Panics if `lo` is greater than `hi`.
+func Clamp[T Ordered](slice []T, lo, hi T) []T {
+	if lo > hi {
+		panic("sliceutils: Clamp lower bound is greater than upper bound")
+	}
+	return Map(slice, func(val T) T { return clampValue(val, lo, hi) })
+}
+
+// Clamps each slice element between `lo` and `hi`, inclusive.
+//
+// Panics if `lo` is greater than `hi`.
+func ClampInPlace[T Ordered](slice []T, lo, hi T) {
+	if lo > hi {
+		panic("sliceutils: ClampInPlace lower bound is greater than upper bound")
+	}
+	MapInPlace(slice, func(val T) T { return clampValue(val, lo, hi) })
+}
+
+// Clamps an index into the valid index range of the slice, i.e. between zero
+// and the index of the last element. Useful for computing valid positions
+// before indexing.
+//
+// Returns -1 on empty or nil slice.
+func ClampIndex[T any](slice []T, index int) int {
+	if len(slice) == 0 {
+		return -1
+	}
+	return clampLen(index, len(slice)-1)
+}
+
+// Separates successful results from errors. Takes parallel slices of values
+// and errors where a nil error at an index means that the value at the same
+// index is a successful result. Returns the successful values and the non-nil
+// errors, both in their original order.
+//
+// Returns nil slices if both slices are nil. Panics if the slices differ in
+// length.
+func CollectResults[T any](values []T, errs []error) ([]T, []error) {
+	if len(values) != len(errs) {
+		panic("sliceutils: CollectResults slices differ in length")
+	}
+	// Preserve nil.
+	if values == nil && errs == nil {
+		return nil, nil
+	}
+	okValues := make([]T, 0)
+	outErrs := make([]error, 0)
+	for i, err := range errs {
+		if err != nil {
+			outErrs = append(outErrs, err)
+		} else {
+			okValues = append(okValues, values[i])
+		}
+	}
+	return okValues, outErrs
+}
+
+// Removes consecutive runs of equal elements keeping the first element of each
+// run. Elements are compared with the equality function. Unlike Deduplicate,
+// only adjacent duplicates are removed, like with the unix `uniq` command.
+//
+// Returns nil on nil slice. Panics on nil equality function.
+func CompactFunc[T any](slice []T, eqFn func(T, T) bool) []T {
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	outSlice := make([]T, 0)
+	for i, val := range slice {
+		if i == 0 || !eqFn(outSlice[len(outSlice)-1], val) {
+			outSlice = append(outSlice, val)
+		}
+	}
+	return outSlice
+}
+
 // Returns true if slice contains given value.
 //
 // Returns false on nil slice.
@@ -53,6 +314,31 @@ func Contains[T comparable](slice []T, value T) bool {
 	return false
 }
 
+// Returns true if slice contains all given values. Slice is converted into a
+// set for lookups, so checking many values is efficient.
+//
+// Returns true on no values.
+func ContainsAll[T comparable](slice []T, values ...T) bool {
+	return IsSuperSet(slice, values)
+}
+
+// Returns true if slice contains any of given values. Slice is converted into
+// a set for lookups, so checking many values is efficient.
+//
+// Returns false on no values.
+func ContainsAny[T comparable](slice []T, values ...T) bool {
+	return !AreDisjoint(values, slice)
+}
+
+// Returns true if slice contains a value for which the predicate function
+// returns true. Equivalent to Any, named for discoverability alongside
+// Contains.
+//
+// Returns false on nil slice. Panics on nil predicate function.
+func ContainsBy[T any](slice []T, predFn func(T) bool) bool {
+	return Any(slice, predFn)
+}
+
 // Count the number of matching items in a slice. Counter is incremented if
 // counter function returns true on them.
 //
@@ -67,6 +353,46 @@ func Count[T any](slice []T, counterFn func(T) bool) int {
 	return count
 }
 
+// Counts slice elements grouped by keys derived with the key function. Returns
+// a map of keys and the number of elements producing each key.
+//
+// Returns nil on nil slice. Panics on nil key function.
+func CountBy[T any, K comparable](slice []T, keyFn func(T) K) map[K]int {
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	outMap := make(map[K]int)
+	for _, val := range slice {
+		// Missing key returns default which is zero.
+		outMap[keyFn(val)]++
+	}
+	return outMap
+}
+
+// Returns the running maxima of the slice, i.e. element at index `i` is the
+// maximum of elements up to and including index `i`. Floating-point NaNs are
+// ordered before all other values like in DeduplicateSortedOutput, so NaN is
+// only the maximum until the first other value.
+//
+// Returns nil on nil slice.
+func CumulativeMax[T Ordered](slice []T) []T {
+	return scan(slice, func(acc, val T) T {
+		if lessOrdered(acc, val) {
+			return val
+		}
+		return acc
+	})
+}
+
+// Returns the running totals of the slice, i.e. element at index `i` is the sum
+// of elements up to and including index `i`.
+//
+// Returns nil on nil slice.
+func CumulativeSum[T Number](slice []T) []T {
+	return scan(slice, func(acc, val T) T { return acc + val })
+}
+
 // Remove duplicate elements. Effectively creates a set. Order of elements is
 // preserved.
 //
@@ -82,388 +408,1813 @@ func Deduplicate[T comparable](slice []T) []T {
 	})
 }
 
-// Remove duplicate elements in place modifying the original slice. Effectively
-// creates a set. Order of elements is preserved. Function takes the slice as a
-// pointer as its length may be modified.
+// Remove elements with duplicate keys returned by the key function. First
+// element with each key is kept. Order of elements is preserved. Useful for
+// elements which are not comparable or should be deduplicated by a field.
 //
-// Does not allocate.
-func DeduplicateInPlace[T comparable](slice *[]T) {
-	uniques := make(map[T]struct{})
-	FilterInPlace(slice, func(val T) bool {
-		_, exists := uniques[val]
+// Returns nil on nil slice. Panics on nil key function.
+func DeduplicateBy[T any, K comparable](slice []T, keyFn func(T) K) []T {
+	uniques := make(map[K]struct{})
+	return Filter(slice, func(val T) bool {
+		key := keyFn(val)
+		_, exists := uniques[key]
 		if !exists {
-			uniques[val] = struct{}{}
+			uniques[key] = struct{}{}
 		}
 		return !exists
 	})
 }
 
-// Creates a difference set from two slices. Resulting set will contain
-// elements from left set which are not in the right set.
+// Remove duplicate elements using a hash function for identity. Elements with
+// equal hashes are considered duplicates and only the first one is kept. Order
+// of elements is preserved. Useful for elements which are not comparable or
+// are expensive to compare.
 //
-// Returns nil if both sets are nil.
-func Difference[T comparable](lhs, rhs []T) []T {
-	uniques := makeSet(rhs)
-	return Filter(lhs, func(val T) bool {
-		_, exists := uniques[val]
-		return !exists
-	})
+// Hash collisions cause distinct elements to be discarded, so the caller must
+// ensure that the hash is effectively unique.
+//
+// Returns nil on nil slice. Panics on nil hash function.
+func DeduplicateByHash[T any](slice []T, hashFn func(T) uint64) []T {
+	return DeduplicateBy(slice, hashFn)
 }
 
-// Filter values in a slice by filter function. Resulting slice will contain
-// values for which the filter function returns true.
+// Remove elements with duplicate keys keeping the element chosen by the
+// resolve function for each key. The resolve function is given the currently
+// kept element and a later element with the same key, and returns the one to
+// keep. Order of the resulting elements follows the first occurrence of each
+// key.
 //
-// Returns nil on nil slice. Panics on nil filter function.
-func Filter[T any](slice []T, filterFn func(T) bool) []T {
+// Returns nil on nil slice. Panics on nil key or resolve function.
+func DeduplicateByResolve[T any, K comparable](slice []T, keyFn func(T) K, betterFn func(existing, candidate T) T) []T {
 	// Preserve nil.
 	if slice == nil {
 		return nil
 	}
+	// Index of the kept element for each key.
+	indices := make(map[K]int)
 	outSlice := make([]T, 0)
 	for _, val := range slice {
-		if filterFn(val) {
+		key := keyFn(val)
+		if idx, exists := indices[key]; exists {
+			outSlice[idx] = betterFn(outSlice[idx], val)
+		} else {
+			indices[key] = len(outSlice)
 			outSlice = append(outSlice, val)
 		}
 	}
 	return outSlice
 }
 
-// Filter values in a slice in place by filter function. Modified slice will
-// contain values for which the filter function returns true. Slice is passed
-// as pointer because its length could be modified.
+// Remove duplicate elements and report whether any were removed. Order of
+// elements is preserved. Useful for skipping further work when the slice
+// already was a set, without checking it separately with IsSet.
 //
-// Does not allocate. Panics on nil filter function.
-func FilterInPlace[T any](slicep *[]T, filterFn func(T) bool) {
+// Returns nil and false on nil slice.
+func DeduplicateChanged[T comparable](slice []T) ([]T, bool) {
+	deduped := Deduplicate(slice)
+	return deduped, len(deduped) != len(slice)
+}
+
+// Remove duplicate elements in place modifying the original slice. Effectively
+// creates a set. Order of elements is preserved. Function takes the slice as a
+// pointer as its length may be modified.
+//
+// Allocates a set of the distinct elements for detecting duplicates. For
+// sorted slices, use DeduplicateInPlaceSorted which does not allocate.
+func DeduplicateInPlace[T comparable](slice *[]T) {
+	uniques := make(map[T]struct{})
+	FilterInPlace(slice, func(val T) bool {
+		_, exists := uniques[val]
+		if !exists {
+			uniques[val] = struct{}{}
+		}
+		return !exists
+	})
+}
+
+// Remove duplicate elements in place from a sorted slice modifying the
+// original slice. As equal elements of a sorted slice are adjacent, only
+// consecutive duplicates are removed. Function takes the slice as a pointer as
+// its length may be modified.
+//
+// Does not allocate. Does nothing on nil slice pointer.
+func DeduplicateInPlaceSorted[T comparable](slicep *[]T) {
 	// Pointer could be nil.
 	if slicep == nil {
 		return
 	}
-	n := 0
-	for _, val := range *slicep {
-		if filterFn(val) {
-			(*slicep)[n] = val
+	slice := *slicep
+	if len(slice) == 0 {
+		return
+	}
+	n := 1
+	for _, val := range slice[1:] {
+		if val != slice[n-1] {
+			slice[n] = val
 			n++
 		}
 	}
 	// Possibly shorten the slice to current length.
-	*slicep = (*slicep)[:n]
+	*slicep = slice[:n]
 }
 
-// Filter and map slice values with filter map function. Resulting slice
-// will contain mapped values for which the filter map function returns true as
-// the second argument. FilterMap is usually more efficient than using Filter
-// and Map separately.
+// Remove duplicate elements and sort the remaining elements in ascending
+// order. Sorts a copy of the slice and collapses adjacent duplicates, which is
+// more efficient than deduplicating with a set and sorting afterwards.
 //
-// Returns nil on nil slice. Panics on nil filter map function.
-func FilterMap[T, U any](slice []T, filterMapFn func(T) (U, bool)) []U {
+// Floating-point NaNs are ordered before all other values and deduplicated
+// into a single NaN, even though NaN is not equal to itself.
+//
+// Returns nil on nil slice.
+func DeduplicateSortedOutput[T Ordered](slice []T) []T {
 	// Preserve nil.
 	if slice == nil {
 		return nil
 	}
-	outSlice := make([]U, 0)
-	for _, val := range slice {
-		if mapped, ok := filterMapFn(val); ok {
-			outSlice = append(outSlice, mapped)
-		}
+	outSlice := make([]T, len(slice))
+	copy(outSlice, slice)
+	sort.Slice(outSlice, func(i, j int) bool { return lessOrdered(outSlice[i], outSlice[j]) })
+	if len(outSlice) == 0 {
+		return outSlice
 	}
-	return outSlice
-}
-
-// Returns index of the found element and true in a tuple. If element is not
-// found, returns zero and false.
-//
-// Returns zero and false on nil slice. Panics on nil find function.
-func FindBy[T any](slice []T, findFn func(T) bool) (int, bool) {
-	for i, val := range slice {
-		if findFn(val) {
-			return i, true
+	n := 1
+	for _, val := range outSlice[1:] {
+		prev := outSlice[n-1]
+		// NaNs are the only values not equal to themselves.
+		if val != prev && !(isNaN(val) && isNaN(prev)) {
+			outSlice[n] = val
+			n++
 		}
 	}
-	return 0, false
+	return outSlice[:n]
 }
 
-// Flattens a N-dimensional slice to a N-1 -dimensional slice. Resulting slice
-// preserves order from the original slice where the first values will be from
-// the first slice.
+// Compares an old and a new slice as sets. Returns the elements only in the
+// new slice as added, the elements only in the old slice as removed, and the
+// elements in both as common. Results are deduplicated; added elements are in
+// the order of the new slice, and removed and common elements in the order of
+// the old slice. More efficient than calling Difference and Intersection
+// separately as only one set is built for each slice.
 //
-// Returns nil on nil slice.
-func Flatten[T any](slice [][]T) []T {
+// Returns nil slices if both slices are nil.
+func DiffSummary[T comparable](old, new []T) (added []T, removed []T, common []T) {
 	// Preserve nil.
-	if slice == nil {
-		return nil
+	if old == nil && new == nil {
+		return nil, nil, nil
 	}
-	outSlice := make([]T, 0)
-	for _, val := range slice {
-		outSlice = append(outSlice, val...)
+	oldSet := makeSet(old)
+	newSet := makeSet(new)
+	added = make([]T, 0)
+	removed = make([]T, 0)
+	common = make([]T, 0)
+	for _, val := range old {
+		// Deleting emitted values deduplicates the results.
+		if _, exists := oldSet[val]; !exists {
+			continue
+		}
+		delete(oldSet, val)
+		if _, exists := newSet[val]; exists {
+			common = append(common, val)
+			delete(newSet, val)
+		} else {
+			removed = append(removed, val)
+		}
 	}
-	return outSlice
-}
-
-// Folds a slice successively into single value. `init` is the initial value
-// for which the fold function is applied. Fold function takes the current
-// folded value and the next slice value and returns the folded value.
-//
-// Return initial value on nil slice. Panics on nil fold function.
-func Fold[T, U any](slice []T, init U, foldFn func(U, T) U) U {
-	for _, val := range slice {
-		init = foldFn(init, val)
+	// Only the values not in the old slice remain in the new set.
+	for _, val := range new {
+		if _, exists := newSet[val]; exists {
+			added = append(added, val)
+			delete(newSet, val)
+		}
 	}
-	return init
+	return added, removed, common
 }
 
-// Returns the frequency of values in a slice. Resulting map contains the found
-// values as keys and their number of occurrences as values.
+// Creates a difference set from two slices. Resulting set will contain
+// elements from left set which are not in the right set.
 //
-// Returns nil on nil slice.
-func Frequencies[T comparable](slice []T) map[T]int {
-	// Preserve nil.
-	if slice == nil {
-		return nil
-	}
-	outMap := make(map[T]int)
-	for _, val := range slice {
-		// Missing value returns default which is zero.
-		outMap[val] = outMap[val] + 1
-	}
-	return outMap
+// Returns nil if both sets are nil.
+func Difference[T comparable](lhs, rhs []T) []T {
+	uniques := makeSet(rhs)
+	return Filter(lhs, func(val T) bool {
+		_, exists := uniques[val]
+		return !exists
+	})
 }
 
-// Generates a new slice of length `num` where element values are generated by
-// given argument function. Argument function is given the slice index as
-// parameter.
+// Creates a difference set from two slices using keys derived with the key
+// function as element identities. Resulting set will contain elements from
+// left set whose keys are not in the right set.
 //
-// Returns empty slice for `num == 0`.
-func Generate[T any](num int, genFn func(idx int) T) []T {
-	outSlice := make([]T, 0, num)
-	for i := 0; i < num; i++ {
-		outSlice = append(outSlice, genFn(i))
+// Returns nil if left set is nil. Panics on nil key function if either set is
+// non-empty.
+func DifferenceBy[T any, K comparable](lhs, rhs []T, keyFn func(T) K) []T {
+	keys := makeSet(Map(rhs, keyFn))
+	return Filter(lhs, func(val T) bool {
+		_, exists := keys[keyFn(val)]
+		return !exists
+	})
+}
+
+// Creates a difference set from multiple slices. Resulting set will contain
+// elements from the first set which are not in any of the rest of the sets,
+// in the order they appear in the first set.
+//
+// Returns nil if the first set is nil.
+func DifferenceN[T comparable](first []T, rest ...[]T) []T {
+	uniques := make(map[T]struct{})
+	for _, slice := range rest {
+		for _, val := range slice {
+			uniques[val] = struct{}{}
+		}
 	}
-	return outSlice
+	return Filter(first, func(val T) bool {
+		_, exists := uniques[val]
+		return !exists
+	})
 }
 
-// Creates a intersection set from two slices. Resulting slice will contain
-// elements which are in left and right sets.
+// Returns the dot product of two slices, i.e. the sum of products of elements
+// with equal indices, and true.
 //
-// Returns nil if both sets are nil.
-func Intersection[T comparable](lhs, rhs []T) []T {
-	if lhs == nil && rhs == nil {
-		return nil
+// Returns zero and false if the slices have different lengths. Returns zero
+// and true on empty slices.
+func DotProduct[T Number](lhs, rhs []T) (T, bool) {
+	if len(lhs) != len(rhs) {
+		return 0, false
 	}
-	uniques := makeSet(rhs)
-	outSlice := make([]T, 0)
-	for _, val := range lhs {
-		if _, exists := uniques[val]; exists {
-			outSlice = append(outSlice, val)
-		}
+	var product T
+	for i := range lhs {
+		product += lhs[i] * rhs[i]
 	}
-	return outSlice
+	return product, true
 }
 
-// Returns true if the slice is a set i.e. contains only unique elements.
+// Drops the first `n` elements and returns the remaining elements. Resulting
+// slice shares the backing array of the original slice, so modifications are
+// visible in both.
 //
-// Returns true on nil slice.
-func IsSet[T comparable](slice []T) bool {
-	uniques := make(map[T]struct{})
-	for _, val := range slice {
-		if _, ok := uniques[val]; ok {
+// `n` is clamped between zero and the length of the slice. Returns nil on nil
+// slice.
+func Drop[T any](slice []T, n int) []T {
+	return slice[clampLen(n, len(slice)):]
+}
+
+// Drops the longest prefix of elements for which the predicate function
+// returns true and returns the remaining elements. Resulting slice shares the
+// backing array of the original slice.
+//
+// Returns nil on nil slice. Panics on nil predicate function.
+func DropWhile[T any](slice []T, predFn func(T) bool) []T {
+	return slice[len(TakeWhile(slice, predFn)):]
+}
+
+// Returns true if two slices have the same length and all their elements are
+// equal at the same indices.
+//
+// Nil and empty slices are equal, i.e. a nil slice is equal to an empty
+// non-nil slice.
+func Equal[T comparable](lhs, rhs []T) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+	for i := range lhs {
+		if lhs[i] != rhs[i] {
 			return false
 		}
-		uniques[val] = struct{}{}
 	}
 	return true
 }
 
-// Returns true if the slice is sorted by given comparison function. For
-// ascending order, pass a comparison function which returns true when left is
-// less than right.
+// Returns true if two slices have the same length and all their elements are
+// equal at the same indices using the equality function.
 //
-// Returns true on nil slice. Panics on nil comparison function.
-func IsSortedBy[T any](slice []T, lessFn func(T, T) bool) bool {
-	for i := 1; i < len(slice); i++ {
-		if lessFn(slice[i], slice[i-1]) {
+// Nil and empty slices are equal, i.e. a nil slice is equal to an empty
+// non-nil slice. Panics on nil equality function.
+func EqualBy[T any](lhs, rhs []T, eqFn func(T, T) bool) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+	for i := range lhs {
+		if !eqFn(lhs[i], rhs[i]) {
 			return false
 		}
 	}
 	return true
 }
 
-// Returns true if all elements of `subset` set are contained within `of` set.
+// Returns true if two sorted slices contain the same elements with the same
+// number of occurrences. Both slices are expected to be sorted by the same
+// order, which allows the comparison to be done in a single pass without
+// allocating. Result is undefined if the slices are not sorted.
 //
-// Empty sets are subsets of non-empty and empty sets.
-func IsSubSet[T comparable](subset, of []T) bool {
-	return IsSuperSet(of, subset)
+// Nil and empty slices are equal.
+func EqualSorted[T comparable](a, b []T) bool {
+	// Sorted slices with equal elements are equal index by index.
+	return Equal(a, b)
 }
 
-// Returns true if `super` set contains all elements of `of` set.
+// Returns true if two slices contain the same elements with the same number of
+// occurrences regardless of order. Elements are compared by the keys returned
+// by the key function, which allows comparing elements that are not
+// comparable, or comparing them after normalization.
 //
-// Non-empty and empty sets are super sets of empty sets.
-func IsSuperSet[T comparable](super, of []T) bool {
-	uniques := makeSet(super)
-	return All(of, func(val T) bool {
-		_, exists := uniques[val]
-		return exists
-	})
+// Nil and empty slices are equal. Panics on nil key function.
+func EqualUnorderedBy[T any, K comparable](a, b []T, keyFn func(T) K) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := make(map[K]int)
+	for _, val := range a {
+		counts[keyFn(val)]++
+	}
+	for _, val := range b {
+		key := keyFn(val)
+		if counts[key] == 0 {
+			return false
+		}
+		counts[key]--
+	}
+	return true
 }
 
-// Join multiple slices together into a single slice. This is a variadic
-// version of Flatten. The effective difference between Join and Flatten is
-// that this returns empty slice on nil slice arguments while Flatten returns
-// nil slice.
+// Creates a new slice of length `n` where every element is `value`.
 //
-// Returns nil on no arguments. Returns empty slice on nil slice arguments.
-func Join[T any](slices ...[]T) []T {
-	// Preserve nil if no arguments.
-	if slices == nil {
-		return nil
-	}
-	outSlice := make([]T, 0)
-	for _, slice := range slices {
-		outSlice = append(outSlice, slice...)
+// Returns empty slice if `n` is not positive.
+func Fill[T any](n int, value T) []T {
+	if n <= 0 {
+		return make([]T, 0)
 	}
+	outSlice := make([]T, n)
+	FillInPlace(outSlice, value)
 	return outSlice
 }
 
-// Maps each slice value with mapping function. Resulting slice contains values
-// returned by the mapping function while preserving order.
+// Sets every slice element to `value`.
 //
-// Returns nil on nil slice. Panics on nil mapping function.
-func Map[T, U any](slice []T, mapFn func(T) U) []U {
+// Does nothing on nil slice.
+func FillInPlace[T any](slice []T, value T) {
+	for i := range slice {
+		slice[i] = value
+	}
+}
+
+// Filter values in a slice by filter function. Resulting slice will contain
+// values for which the filter function returns true.
+//
+// Returns nil on nil slice. Panics on nil filter function.
+func Filter[T any](slice []T, filterFn func(T) bool) []T {
 	// Preserve nil.
 	if slice == nil {
 		return nil
 	}
-	// Reserve capacity eagerly to allocate only once.
-	outSlice := make([]U, 0, len(slice))
+	outSlice := make([]T, 0)
 	for _, val := range slice {
-		outSlice = append(outSlice, mapFn(val))
+		if filterFn(val) {
+			outSlice = append(outSlice, val)
+		}
 	}
 	return outSlice
 }
 
-// Maps each slice element to a new value of the same type using a mapping
-// function.
+// Filter values in a slice in place by filter function. Modified slice will
+// contain values for which the filter function returns true. Slice is passed
+// as pointer because its length could be modified.
 //
-// Panics on nil mapping function.
-func MapInPlace[T any](slice []T, mapFn func(T) T) {
-	for i := 0; i < len(slice); i++ {
-		slice[i] = mapFn(slice[i])
+// Does not allocate. Panics on nil filter function.
+func FilterInPlace[T any](slicep *[]T, filterFn func(T) bool) {
+	// Pointer could be nil.
+	if slicep == nil {
+		return
+	}
+	n := 0
+	for _, val := range *slicep {
+		if filterFn(val) {
+			(*slicep)[n] = val
+			n++
+		}
 	}
+	// Possibly shorten the slice to current length.
+	*slicep = (*slicep)[:n]
 }
 
-// Returns the maximum element value and true from non-empty slice using
-// the provided comparison function. To get maximum value, pass a comparison
-// function which returns true when left is less than right. Function is
-// stable, i.e. returns the first occurrence of maximum value.
+// Filter values in a slice by filter function which is also given the index of
+// the value. Resulting slice will contain values for which the filter function
+// returns true.
 //
-// If slice is empty, returns zero value of type T and false.
-func MaxBy[T any](slice []T, lessFn func(T, T) bool) (T, bool) {
-	if len(slice) == 0 {
-		return zeroValue[T](), false
+// Returns nil on nil slice. Panics on nil filter function.
+func FilterIndexed[T any](slice []T, predFn func(int, T) bool) []T {
+	// Preserve nil.
+	if slice == nil {
+		return nil
 	}
-	max := slice[0]
-	for _, val := range slice[1:] {
-		if lessFn(max, val) {
-			max = val
+	outSlice := make([]T, 0)
+	for i, val := range slice {
+		if predFn(i, val) {
+			outSlice = append(outSlice, val)
 		}
 	}
-	return max, true
+	return outSlice
 }
 
-// Returns the minimum element value and true from non-empty slice using
-// the provided comparison function. To get minimum value, pass a comparison
-// function which returns true when left is less than right. Function is
-// stable, i.e. returns the first occurrence of minimum value.
+// Filter and map slice values with filter map function. Resulting slice
+// will contain mapped values for which the filter map function returns true as
+// the second argument. FilterMap is usually more efficient than using Filter
+// and Map separately.
 //
-// If slice is empty, returns zero value of type T and false.
-func MinBy[T any](slice []T, lessFn func(T, T) bool) (T, bool) {
-	if len(slice) == 0 {
-		return zeroValue[T](), false
+// Returns nil on nil slice. Panics on nil filter map function.
+func FilterMap[T, U any](slice []T, filterMapFn func(T) (U, bool)) []U {
+	// Preserve nil.
+	if slice == nil {
+		return nil
 	}
-	min := slice[0]
-	for _, val := range slice[1:] {
-		if lessFn(val, min) {
-			min = val
+	outSlice := make([]U, 0)
+	for _, val := range slice {
+		if mapped, ok := filterMapFn(val); ok {
+			outSlice = append(outSlice, mapped)
 		}
 	}
-	return min, true
+	return outSlice
 }
 
-// Partition single slice into two slices using partition function. The first
-// returned slice contains values for which the partition function returns true,
-// and the second slice values for which the function returns false.
+// Filter and map slice values with filter map function which is also given
+// the index of the value. Resulting slice will contain mapped values for which
+// the filter map function returns true as the second argument.
 //
-// Returns nil slices on nil slice. Panics on nil partition function.
-func Partition[T any](slice []T, firstPart func(T) bool) ([]T, []T) {
+// Returns nil on nil slice. Panics on nil filter map function.
+func FilterMapIndex[T, U any](slice []T, filterMapFn func(idx int, val T) (U, bool)) []U {
 	// Preserve nil.
 	if slice == nil {
-		return nil, nil
+		return nil
 	}
-	trueSlice := make([]T, 0)
-	falseSlice := make([]T, 0)
-	for _, val := range slice {
-		if firstPart(val) {
-			trueSlice = append(trueSlice, val)
-		} else {
-			falseSlice = append(falseSlice, val)
+	outSlice := make([]U, 0)
+	for i, val := range slice {
+		if mapped, ok := filterMapFn(i, val); ok {
+			outSlice = append(outSlice, mapped)
 		}
 	}
-	return trueSlice, falseSlice
+	return outSlice
 }
 
-// Partition slice in place using partition function. First part contains
-// elements for which the partition function returns true, and the second part
-// values for which the function returns false. Function returns the index
-// of the first element in the second partition.
+// Returns index of the found element and true in a tuple. If element is not
+// found, returns zero and false.
 //
-// Does not allocate. Panics on nil partition function.
-func PartitionInPlace[T any](slice []T, firstPart func(T) bool) int {
-	fwd := -1
-	bck := len(slice)
-	for {
-		// Increment indexes until elements at wrong partitions are found.
-		fwd++
-		for fwd < bck && firstPart(slice[fwd]) {
-			fwd++
-		}
-		bck--
-		for fwd < bck && !firstPart(slice[bck]) {
-			bck--
-		}
-		if fwd >= bck {
-			return fwd
+// Returns zero and false on nil slice. Panics on nil find function.
+func FindBy[T any](slice []T, findFn func(T) bool) (int, bool) {
+	for i, val := range slice {
+		if findFn(val) {
+			return i, true
 		}
-		// Swap elements in wrong partitions.
-		slice[fwd], slice[bck] = slice[bck], slice[fwd]
 	}
+	return 0, false
 }
 
-// Reverses the order of elements in a slice.
+// Returns the indices of all elements for which the predicate function returns
+// true in ascending order.
 //
-// Returns nil on nil slice.
-func Reverse[T any](slice []T) []T {
+// Returns empty slice if no element satisfies the predicate. Returns nil on
+// nil slice. Panics on nil predicate function.
+func FindIndices[T any](slice []T, predFn func(T) bool) []int {
 	// Preserve nil.
 	if slice == nil {
 		return nil
 	}
-	i := len(slice) - 1
-	outSlice := make([]T, len(slice))
-	for _, val := range slice {
-		outSlice[i] = val
-		i--
+	outSlice := make([]int, 0)
+	for i, val := range slice {
+		if predFn(val) {
+			outSlice = append(outSlice, i)
+		}
 	}
 	return outSlice
 }
 
-// Reverses the order of elements in a slice.
+// Returns index of the last found element and true in a tuple. Slice is
+// searched starting from the end. If element is not found, returns zero and
+// false.
 //
-// Does not allocate.
-func ReverseInPlace[T any](slice []T) {
-	l := len(slice)
-	for i := 0; i < l/2; i++ {
+// Returns zero and false on nil slice. Panics on nil find function.
+func FindLastBy[T any](slice []T, findFn func(T) bool) (int, bool) {
+	for i := len(slice) - 1; i >= 0; i-- {
+		if findFn(slice[i]) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// Returns the first mapped value and true for which the find map function
+// returns true as the second argument. Finding stops at the first match. If no
+// value is found, returns zero value of type U and false.
+//
+// Returns zero value and false on nil slice. Panics on nil find map function.
+func FindMap[T, U any](slice []T, fn func(T) (U, bool)) (U, bool) {
+	for _, val := range slice {
+		if mapped, ok := fn(val); ok {
+			return mapped, true
+		}
+	}
+	return zeroValue[U](), false
+}
+
+// Maps each slice value to a slice with mapping function and flattens the
+// results into a single slice while preserving order. FlatMap is more
+// efficient than using Map and Flatten separately as it appends the mapped
+// slices directly into the result. Nil slices returned by the mapping function
+// are skipped.
+//
+// Returns nil on nil slice. Panics on nil mapping function.
+func FlatMap[T, U any](slice []T, mapFn func(T) []U) []U {
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	outSlice := make([]U, 0)
+	for _, val := range slice {
+		outSlice = append(outSlice, mapFn(val)...)
+	}
+	return outSlice
+}
+
+// Flattens a N-dimensional slice to a N-1 -dimensional slice. Resulting slice
+// preserves order from the original slice where the first values will be from
+// the first slice.
+//
+// Returns nil on nil slice.
+func Flatten[T any](slice [][]T) []T {
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	outSlice := make([]T, 0)
+	for _, val := range slice {
+		outSlice = append(outSlice, val...)
+	}
+	return outSlice
+}
+
+// Flattens a N-dimensional slice to a N-1 -dimensional slice inserting the
+// separator elements between each sub-slice. Separator is not inserted before
+// the first or after the last sub-slice. Similar to strings.Join.
+//
+// Returns nil on nil slice.
+func FlattenWithSeparator[T any](slice [][]T, sep []T) []T {
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	outSlice := make([]T, 0)
+	for i, val := range slice {
+		if i > 0 {
+			outSlice = append(outSlice, sep...)
+		}
+		outSlice = append(outSlice, val...)
+	}
+	return outSlice
+}
+
+// Folds a slice successively into single value. `init` is the initial value
+// for which the fold function is applied. Fold function takes the current
+// folded value and the next slice value and returns the folded value.
+//
+// Return initial value on nil slice. Panics on nil fold function.
+func Fold[T, U any](slice []T, init U, foldFn func(U, T) U) U {
+	for _, val := range slice {
+		init = foldFn(init, val)
+	}
+	return init
+}
+
+// Folds a slice successively into single value while the fold function allows
+// it. This is the most general fold of the package. Fold function takes the
+// current folded value, and the index and value of the next slice element. It
+// returns the folded value and whether to continue folding. When the fold
+// function returns false, its returned value is the result and the remaining
+// elements are not visited.
+//
+// Returns initial value on nil slice. Panics on nil fold function.
+func FoldIndexWhile[T, U any](slice []T, init U, foldFn func(acc U, idx int, val T) (U, bool)) U {
+	for i, val := range slice {
+		var ok bool
+		if init, ok = foldFn(init, i, val); !ok {
+			break
+		}
+	}
+	return init
+}
+
+// Folds a slice successively into single value starting from the last element.
+// `init` is the initial value for which the fold function is applied. Fold
+// function takes the next slice value and the current folded value and returns
+// the folded value. Useful for right-associative operations.
+//
+// Return initial value on nil slice. Panics on nil fold function.
+func FoldRight[T, U any](slice []T, init U, foldFn func(T, U) U) U {
+	for i := len(slice) - 1; i >= 0; i-- {
+		init = foldFn(slice[i], init)
+	}
+	return init
+}
+
+// Calls the argument function for each pair of adjacent elements in order,
+// i.e. for `slice[i]` and `slice[i+1]`. Useful for computing deltas and
+// detecting transitions.
+//
+// Does nothing on slices with fewer than two elements. Panics on nil function.
+func ForEachPair[T any](slice []T, fn func(prev, cur T)) {
+	for i := 1; i < len(slice); i++ {
+		fn(slice[i-1], slice[i])
+	}
+}
+
+// Returns the frequency of values in a slice. Resulting map contains the found
+// values as keys and their number of occurrences as values.
+//
+// Returns nil on nil slice.
+func Frequencies[T comparable](slice []T) map[T]int {
+	return CountBy(slice, func(val T) T { return val })
+}
+
+// Receives values from the channel until it is closed and returns them as a
+// slice in the order they were received. Blocks until the channel is closed.
+//
+// Returns empty slice if the channel is closed without values. Blocks forever
+// on nil channel.
+func FromChannel[T any](ch <-chan T) []T {
+	outSlice := make([]T, 0)
+	for val := range ch {
+		outSlice = append(outSlice, val)
+	}
+	return outSlice
+}
+
+// Generates a new slice of length `num` where element values are generated by
+// given argument function. Argument function is given the slice index as
+// parameter.
+//
+// Returns empty slice for `num == 0`.
+func Generate[T any](num int, genFn func(idx int) T) []T {
+	outSlice := make([]T, 0, num)
+	for i := 0; i < num; i++ {
+		outSlice = append(outSlice, genFn(i))
+	}
+	return outSlice
+}
+
+// Generates a new matrix with `rows` rows and `cols` columns where element
+// values are generated by given argument function. Argument function is given
+// the row and column index as parameters.
+//
+// Returns empty slice for `rows == 0`. Panics on negative dimensions.
+func Generate2D[T any](rows, cols int, genFn func(r, c int) T) [][]T {
+	if rows < 0 || cols < 0 {
+		panic("sliceutils: negative Generate2D dimensions")
+	}
+	return Generate(rows, func(r int) []T {
+		return Generate(cols, func(c int) T { return genFn(r, c) })
+	})
+}
+
+// Generates a new slice by calling the generator function with increasing
+// indices starting from zero for as long as the while function returns true
+// for the generated value. The first value for which the while function
+// returns false is not included.
+//
+// Returns empty slice if the first generated value is rejected. Panics on nil
+// generator or while function.
+func GenerateWhile[T any](genFn func(idx int) T, whileFn func(T) bool) []T {
+	outSlice := make([]T, 0)
+	for i := 0; ; i++ {
+		val := genFn(i)
+		if !whileFn(val) {
+			return outSlice
+		}
+		outSlice = append(outSlice, val)
+	}
+}
+
+// Groups slice values by keys returned by the key function. Resulting map
+// contains the found keys as keys and the values which produced them as
+// values. Order of values within each group is preserved.
+//
+// Returns nil on nil slice. Panics on nil key function.
+func GroupBy[T any, K comparable](slice []T, keyFn func(T) K) map[K][]T {
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	outMap := make(map[K][]T)
+	for _, val := range slice {
+		key := keyFn(val)
+		outMap[key] = append(outMap[key], val)
+	}
+	return outMap
+}
+
+// Returns the first element of the slice and true.
+//
+// Returns zero value and false on empty or nil slice.
+func Head[T any](slice []T) (T, bool) {
+	if len(slice) == 0 {
+		return zeroValue[T](), false
+	}
+	return slice[0], true
+}
+
+// Returns index of the first occurrence of given value and true. If value is
+// not found, returns zero and false.
+//
+// Returns zero and false on nil slice.
+func IndexOf[T comparable](slice []T, value T) (int, bool) {
+	return FindBy(slice, func(val T) bool { return val == value })
+}
+
+// Returns all elements except the last one. Resulting slice shares the backing
+// array of the original slice, so modifications are visible in both.
+//
+// Returns empty slice on slices with less than two elements. Returns nil on
+// nil slice.
+func Init[T any](slice []T) []T {
+	return Take(slice, len(slice)-1)
+}
+
+// Creates a new slice with `values` inserted before the element at index `idx`,
+// in the order they are given. Index equal to the length of the slice appends
+// the values to the end. Original slice is not modified.
+//
+// Panics if `idx` is negative or greater than the length of the slice.
+func Insert[T any](slice []T, idx int, values ...T) []T {
+	if idx < 0 || idx > len(slice) {
+		panic("sliceutils: Insert index out of range")
+	}
+	outSlice := make([]T, 0, len(slice)+len(values))
+	outSlice = append(outSlice, slice[:idx]...)
+	outSlice = append(outSlice, values...)
+	return append(outSlice, slice[idx:]...)
+}
+
+// Interleaves multiple slices into a single slice by taking one element from
+// each slice in turn. Slices which run out of elements are skipped, so
+// `[1, 2, 3]` and `[4, 5]` are interleaved into `[1, 4, 2, 5, 3]`.
+//
+// Returns nil if no arguments. Nil slices are treated as empty.
+func Interleave[T any](slices ...[]T) []T {
+	// Preserve nil if no arguments.
+	if slices == nil {
+		return nil
+	}
+	total, longest := 0, 0
+	for _, slice := range slices {
+		total += len(slice)
+		if len(slice) > longest {
+			longest = len(slice)
+		}
+	}
+	outSlice := make([]T, 0, total)
+	for i := 0; i < longest; i++ {
+		for _, slice := range slices {
+			if i < len(slice) {
+				outSlice = append(outSlice, slice[i])
+			}
+		}
+	}
+	return outSlice
+}
+
+// Creates an intersection set from two slices. Resulting slice will contain
+// elements of the left set which are also in the right set, in the order of
+// the left set. Both slices are expected to be sets; if the left slice
+// contains duplicates, they are all retained.
+//
+// Intersection comes in three flavors which differ in how duplicates are
+// handled, e.g. for `[1, 1, 1]` and `[1, 1]`:
+//   - Left-ordered intersection, this function and IntersectionOrdered, keeps
+//     every left element contained in the right slice: `[1, 1, 1]`.
+//   - Set intersection, IntersectionN, keeps each common element once: `[1]`.
+//   - Multiset intersection keeps each common element as many times as it
+//     occurs in both slices at minimum: `[1, 1]`. It is not provided by this
+//     package.
+//
+// Returns nil if both sets are nil.
+func Intersection[T comparable](lhs, rhs []T) []T {
+	if lhs == nil && rhs == nil {
+		return nil
+	}
+	uniques := makeSet(rhs)
+	outSlice := make([]T, 0)
+	for _, val := range lhs {
+		if _, exists := uniques[val]; exists {
+			outSlice = append(outSlice, val)
+		}
+	}
+	return outSlice
+}
+
+// Creates an intersection set from two slices using keys derived with the key
+// function as element identities. Resulting slice will contain elements from
+// left set whose keys are also in the right set.
+//
+// Returns nil if both sets are nil. Panics on nil key function if either set
+// is non-empty.
+func IntersectionBy[T any, K comparable](lhs, rhs []T, keyFn func(T) K) []T {
+	if lhs == nil && rhs == nil {
+		return nil
+	}
+	keys := makeSet(Map(rhs, keyFn))
+	outSlice := make([]T, 0)
+	for _, val := range lhs {
+		if _, exists := keys[keyFn(val)]; exists {
+			outSlice = append(outSlice, val)
+		}
+	}
+	return outSlice
+}
+
+// Creates an intersection set from multiple slices. Resulting set will contain
+// elements which are in every set, without duplicates and in the order they
+// are first seen in the first set.
+//
+// Returns nil on no slices or if all slices are nil. Returns the deduplicated
+// slice on a single slice.
+func IntersectionN[T comparable](slices ...[]T) []T {
+	if All(slices, func(slice []T) bool { return slice == nil }) {
+		return nil
+	}
+	sets := Map(slices[1:], makeSet[T])
+	seen := make(map[T]struct{})
+	outSlice := make([]T, 0)
+	for _, val := range slices[0] {
+		if _, exists := seen[val]; exists {
+			continue
+		}
+		seen[val] = struct{}{}
+		inAll := All(sets, func(set map[T]struct{}) bool {
+			_, exists := set[val]
+			return exists
+		})
+		if inAll {
+			outSlice = append(outSlice, val)
+		}
+	}
+	return outSlice
+}
+
+// Creates an intersection from two slices treating the left slice as an
+// ordered sequence and the right slice as a set. Resulting slice will contain
+// every element of the left slice, in order and including duplicates, which is
+// also contained in the right slice. Behaves like Intersection but names the
+// left-ordered flavor explicitly; see Intersection for a comparison of the
+// intersection flavors.
+//
+// Returns nil if both slices are nil.
+func IntersectionOrdered[T comparable](lhs, rhs []T) []T {
+	return Intersection(lhs, rhs)
+}
+
+// Returns true if the slice is a set i.e. contains only unique elements.
+//
+// Returns true on nil slice.
+func IsSet[T comparable](slice []T) bool {
+	uniques := make(map[T]struct{})
+	for _, val := range slice {
+		if _, ok := uniques[val]; ok {
+			return false
+		}
+		uniques[val] = struct{}{}
+	}
+	return true
+}
+
+// Returns true if the slice is sorted by given comparison function. For
+// ascending order, pass a comparison function which returns true when left is
+// less than right.
+//
+// Returns true on nil slice. Panics on nil comparison function.
+func IsSortedBy[T any](slice []T, lessFn func(T, T) bool) bool {
+	for i := 1; i < len(slice); i++ {
+		if lessFn(slice[i], slice[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns true if all elements of `subset` set are contained within `of` set.
+//
+// Empty sets are subsets of non-empty and empty sets.
+func IsSubSet[T comparable](subset, of []T) bool {
+	return IsSuperSet(of, subset)
+}
+
+// Returns true if `super` set contains all elements of `of` set.
+//
+// Non-empty and empty sets are super sets of empty sets.
+func IsSuperSet[T comparable](super, of []T) bool {
+	uniques := makeSet(super)
+	return All(of, func(val T) bool {
+		_, exists := uniques[val]
+		return exists
+	})
+}
+
+// Join multiple slices together into a single slice. This is a variadic
+// version of Flatten. The effective difference between Join and Flatten is
+// that this returns empty slice on nil slice arguments while Flatten returns
+// nil slice.
+//
+// Returns nil on no arguments. Returns empty slice on nil slice arguments.
+func Join[T any](slices ...[]T) []T {
+	// Preserve nil if no arguments.
+	if slices == nil {
+		return nil
+	}
+	outSlice := make([]T, 0)
+	for _, slice := range slices {
+		outSlice = append(outSlice, slice...)
+	}
+	return outSlice
+}
+
+// Returns the last element of the slice and true.
+//
+// Returns zero value and false on empty or nil slice.
+func Last[T any](slice []T) (T, bool) {
+	if len(slice) == 0 {
+		return zeroValue[T](), false
+	}
+	return slice[len(slice)-1], true
+}
+
+// Returns index of the last occurrence of given value and true. If value is not
+// found, returns zero and false.
+//
+// Returns zero and false on nil slice.
+func LastIndexOf[T comparable](slice []T, value T) (int, bool) {
+	return FindLastBy(slice, func(val T) bool { return val == value })
+}
+
+// Returns the `n` least common slice elements paired with their number of
+// occurrences in ascending order of occurrences. Elements with equal number of
+// occurrences are ordered by their first appearance.
+//
+// `n` is clamped between zero and the number of distinct elements. Returns nil
+// on nil slice.
+func LeastCommon[T comparable](slice []T, n int) []Pair[T, int] {
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	var counter Counter[T]
+	counter.AddAll(slice)
+	return counter.LeastCommon(n)
+}
+
+// Maps each slice value with mapping function. Resulting slice contains values
+// returned by the mapping function while preserving order.
+//
+// Returns nil on nil slice. Panics on nil mapping function.
+func Map[T, U any](slice []T, mapFn func(T) U) []U {
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	// Reserve capacity eagerly to allocate only once.
+	outSlice := make([]U, 0, len(slice))
+	for _, val := range slice {
+		outSlice = append(outSlice, mapFn(val))
+	}
+	return outSlice
+}
+
+// Maps values received from the input channel through the mapping function
+// and sends them on the returned channel in the same order. Mapping is done in
+// a single goroutine which closes the returned channel once the input channel
+// is closed. Returned channel is unbuffered, so the goroutine stays alive until
+// all mapped values are received.
+//
+// Panics on nil mapping function.
+func MapChannel[T, U any](in <-chan T, fn func(T) U) <-chan U {
+	// Check before starting the goroutine where panic could not be recovered
+	// by the caller.
+	if fn == nil {
+		panic("sliceutils: nil MapChannel mapping function")
+	}
+	out := make(chan U)
+	go func() {
+		defer close(out)
+		for val := range in {
+			out <- fn(val)
+		}
+	}()
+	return out
+}
+
+// Maps each slice element to a new value of the same type using a mapping
+// function.
+//
+// Panics on nil mapping function.
+func MapInPlace[T any](slice []T, mapFn func(T) T) {
+	for i := 0; i < len(slice); i++ {
+		slice[i] = mapFn(slice[i])
+	}
+}
+
+// Maps each slice value with mapping function which is also given the index of
+// the value. Resulting slice contains values returned by the mapping function
+// while preserving order.
+//
+// Returns nil on nil slice. Panics on nil mapping function.
+func MapIndexed[T, U any](slice []T, mapFn func(int, T) U) []U {
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	// Reserve capacity eagerly to allocate only once.
+	outSlice := make([]U, 0, len(slice))
+	for i, val := range slice {
+		outSlice = append(outSlice, mapFn(i, val))
+	}
+	return outSlice
+}
+
+// Maps each source slice value with mapping function writing the results into
+// the destination slice. Resulting slice has the length of the source slice
+// and uses the backing array of the destination slice if it has enough
+// capacity. Otherwise a new slice is allocated like with append. This allows
+// reusing a buffer across calls, e.g. `buf = MapInto(buf, src, mapFn)`.
+//
+// Previous elements of the destination slice are overwritten. Returns `dst`
+// resliced to zero length on nil or empty source slice. Panics on nil mapping
+// function.
+func MapInto[T, U any](dst []U, src []T, mapFn func(T) U) []U {
+	outSlice := dst[:0]
+	if cap(outSlice) < len(src) {
+		// Reserve capacity eagerly to allocate only once.
+		outSlice = make([]U, 0, len(src))
+	}
+	for _, val := range src {
+		outSlice = append(outSlice, mapFn(val))
+	}
+	return outSlice
+}
+
+// Returns the maximum element value and true from non-empty slice using
+// the provided comparison function. To get maximum value, pass a comparison
+// function which returns true when left is less than right. Function is
+// stable, i.e. returns the first occurrence of maximum value.
+//
+// If slice is empty, returns zero value of type T and false.
+func MaxBy[T any](slice []T, lessFn func(T, T) bool) (T, bool) {
+	if len(slice) == 0 {
+		return zeroValue[T](), false
+	}
+	max := slice[0]
+	for _, val := range slice[1:] {
+		if lessFn(max, val) {
+			max = val
+		}
+	}
+	return max, true
+}
+
+// Returns the largest of the arguments. Requiring the first argument
+// guarantees that there is at least one value to return. Returns the first
+// occurrence of the largest value.
+func MaxOf[T Ordered](first T, rest ...T) T {
+	max := first
+	for _, val := range rest {
+		if val > max {
+			max = val
+		}
+	}
+	return max
+}
+
+// Returns the median element of the slice and true using given comparison
+// function. For ascending order, pass a comparison function which returns true
+// when left is less than right. As elements of arbitrary type cannot be
+// averaged, the lower of the two middle elements is returned for even-length
+// slices. Slice is not modified; elements are sorted in a copy.
+//
+// Returns zero value and false on empty or nil slice. Panics on nil comparison
+// function.
+func Median[T any](slice []T, lessFn func(T, T) bool) (T, bool) {
+	if len(slice) == 0 {
+		return zeroValue[T](), false
+	}
+	sorted := SortBy(slice, lessFn)
+	return sorted[(len(sorted)-1)/2], true
+}
+
+// Merges two sorted slices into a new sorted slice in linear time using given
+// comparison function. For ascending order, pass a comparison function which
+// returns true when left is less than right. Merge is stable, i.e. elements of
+// the left slice come before equal elements of the right slice. Result is
+// undefined if the slices are not sorted by the comparison function.
+//
+// Returns nil if both slices are nil. Panics on nil comparison function if
+// both slices are non-empty.
+func MergeSorted[T any](lhs, rhs []T, lessFn func(T, T) bool) []T {
+	// Preserve nil.
+	if lhs == nil && rhs == nil {
+		return nil
+	}
+	outSlice := make([]T, 0, len(lhs)+len(rhs))
+	i, j := 0, 0
+	for i < len(lhs) && j < len(rhs) {
+		// Take from the right only if strictly less to keep the merge stable.
+		if lessFn(rhs[j], lhs[i]) {
+			outSlice = append(outSlice, rhs[j])
+			j++
+		} else {
+			outSlice = append(outSlice, lhs[i])
+			i++
+		}
+	}
+	outSlice = append(outSlice, lhs[i:]...)
+	return append(outSlice, rhs[j:]...)
+}
+
+// Returns the minimum element value and true from non-empty slice using
+// the provided comparison function. To get minimum value, pass a comparison
+// function which returns true when left is less than right. Function is
+// stable, i.e. returns the first occurrence of minimum value.
+//
+// If slice is empty, returns zero value of type T and false.
+func MinBy[T any](slice []T, lessFn func(T, T) bool) (T, bool) {
+	if len(slice) == 0 {
+		return zeroValue[T](), false
+	}
+	min := slice[0]
+	for _, val := range slice[1:] {
+		if lessFn(val, min) {
+			min = val
+		}
+	}
+	return min, true
+}
+
+// Returns the smallest of the arguments. Requiring the first argument
+// guarantees that there is at least one value to return. Returns the first
+// occurrence of the smallest value.
+func MinOf[T Ordered](first T, rest ...T) T {
+	min := first
+	for _, val := range rest {
+		if val < min {
+			min = val
+		}
+	}
+	return min
+}
+
+// Returns the most frequently occurring element of the slice, its number of
+// occurrences and true. On ties, the element which first reaches the maximum
+// number of occurrences when going through the slice from the start wins,
+// e.g. mode of `[1, 2, 2, 1]` is 2.
+//
+// Returns zero value, zero and false on empty or nil slice.
+func Mode[T comparable](slice []T) (T, int, bool) {
+	if len(slice) == 0 {
+		return zeroValue[T](), 0, false
+	}
+	maxCount := 0
+	for _, count := range Frequencies(slice) {
+		if count > maxCount {
+			maxCount = count
+		}
+	}
+	counts := make(map[T]int)
+	for _, val := range slice {
+		counts[val]++
+		if counts[val] == maxCount {
+			return val, maxCount, true
+		}
+	}
+	// Unreachable as some element always reaches the maximum count.
+	return zeroValue[T](), 0, false
+}
+
+// Returns the `n` most common slice elements paired with their number of
+// occurrences in descending order of occurrences. Elements with equal number of
+// occurrences are ordered by their first appearance.
+//
+// `n` is clamped between zero and the number of distinct elements. Returns nil
+// on nil slice.
+func MostCommon[T comparable](slice []T, n int) []Pair[T, int] {
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	var counter Counter[T]
+	counter.AddAll(slice)
+	return counter.MostCommon(n)
+}
+
+// Multiplies elements of two slices together by their indices up to the length
+// of the shorter slice.
+//
+// Returns nil if either slice is nil.
+func Mul[T Number](lhs, rhs []T) []T {
+	return ZipWith(lhs, rhs, func(a, b T) T { return a * b })
+}
+
+// Returns the element at index `idx` and true. Negative index counts from the
+// end of the slice, so -1 refers to the last element.
+//
+// Returns zero value and false if the index is out of range.
+func Nth[T any](slice []T, idx int) (T, bool) {
+	if idx < 0 {
+		idx += len(slice)
+	}
+	if idx < 0 || idx >= len(slice) {
+		return zeroValue[T](), false
+	}
+	return slice[idx], true
+}
+
+// Returns the element at index `idx`, or `fallback` if the index is out of
+// range. Negative index counts from the end of the slice like in Nth.
+func NthOr[T any](slice []T, idx int, fallback T) T {
+	if val, ok := Nth(slice, idx); ok {
+		return val
+	}
+	return fallback
+}
+
+// Groups slice elements into non-overlapping pairs of adjacent elements, i.e.
+// `slice[0]` with `slice[1]`, `slice[2]` with `slice[3]` and so on. Useful for
+// flat key-value sequences. Trailing element of an odd-length slice is dropped.
+//
+// Returns nil on nil slice.
+func Pairs[T any](slice []T) []Pair[T, T] {
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	outSlice := make([]Pair[T, T], 0, len(slice)/2)
+	for i := 1; i < len(slice); i += 2 {
+		outSlice = append(outSlice, Pair[T, T]{First: slice[i-1], Second: slice[i]})
+	}
+	return outSlice
+}
+
+// Creates overlapping pairs of adjacent elements, i.e. `slice[0]` with
+// `slice[1]`, `slice[1]` with `slice[2]` and so on. Useful for computing
+// differences between consecutive elements. Unlike Pairs, every element except
+// the first and the last is part of two pairs.
+//
+// Returns empty slice on slices with less than two elements. Returns nil on
+// nil slice.
+func Pairwise[T any](slice []T) []Pair[T, T] {
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	outSlice := make([]Pair[T, T], 0, clampLen(len(slice)-1, len(slice)))
+	ForEachPair(slice, func(prev, cur T) {
+		outSlice = append(outSlice, Pair[T, T]{First: prev, Second: cur})
+	})
+	return outSlice
+}
+
+// Partition single slice into two slices using partition function. The first
+// returned slice contains values for which the partition function returns true,
+// and the second slice values for which the function returns false.
+//
+// Returns nil slices on nil slice. Panics on nil partition function.
+func Partition[T any](slice []T, firstPart func(T) bool) ([]T, []T) {
+	// Preserve nil.
+	if slice == nil {
+		return nil, nil
+	}
+	trueSlice := make([]T, 0)
+	falseSlice := make([]T, 0)
+	for _, val := range slice {
+		if firstPart(val) {
+			trueSlice = append(trueSlice, val)
+		} else {
+			falseSlice = append(falseSlice, val)
+		}
+	}
+	return trueSlice, falseSlice
+}
+
+// Partition slice in place using partition function. First part contains
+// elements for which the partition function returns true, and the second part
+// values for which the function returns false. Function returns the index
+// of the first element in the second partition.
+//
+// Does not allocate. Panics on nil partition function.
+func PartitionInPlace[T any](slice []T, firstPart func(T) bool) int {
+	fwd := -1
+	bck := len(slice)
+	for {
+		// Increment indexes until elements at wrong partitions are found.
+		fwd++
+		for fwd < bck && firstPart(slice[fwd]) {
+			fwd++
+		}
+		bck--
+		for fwd < bck && !firstPart(slice[bck]) {
+			bck--
+		}
+		if fwd >= bck {
+			return fwd
+		}
+		// Swap elements in wrong partitions.
+		slice[fwd], slice[bck] = slice[bck], slice[fwd]
+	}
+}
+
+// Maps and partitions a slice at once. Partition function returns a left
+// value, a right value and whether to emit the left value. The first returned
+// slice contains left values for which the function returned true, and the
+// second slice right values for which the function returned false. Useful for
+// e.g. separating successfully parsed values from failures.
+//
+// Returns nil slices on nil slice. Panics on nil partition function.
+func PartitionMap[T, L, R any](slice []T, fn func(T) (L, R, bool)) ([]L, []R) {
+	// Preserve nil.
+	if slice == nil {
+		return nil, nil
+	}
+	leftSlice := make([]L, 0)
+	rightSlice := make([]R, 0)
+	for _, val := range slice {
+		left, right, isLeft := fn(val)
+		if isLeft {
+			leftSlice = append(leftSlice, left)
+		} else {
+			rightSlice = append(rightSlice, right)
+		}
+	}
+	return leftSlice, rightSlice
+}
+
+// Generates an arithmetic sequence of integers from `start` up to, but not
+// including, `stop` with increments of `step`. Negative step generates a
+// descending sequence.
+//
+// Returns empty slice if the step points away from `stop` or if `start`
+// equals `stop`. Panics on zero step.
+func Range[T Integer](start, stop, step T) []T {
+	if step == 0 {
+		panic("sliceutils: zero Range step")
+	}
+	outSlice := make([]T, 0)
+	for val := start; (step > 0 && val < stop) || (step < 0 && val > stop); {
+		outSlice = append(outSlice, val)
+		next := val + step
+		// Stop if the next value would wrap around.
+		if (step > 0 && next <= val) || (step < 0 && next >= val) {
+			break
+		}
+		val = next
+	}
+	return outSlice
+}
+
+// Reduces a slice successively into single value using the first element as
+// the initial value. Reduce function takes the current reduced value and the
+// next slice value and returns the reduced value. Returns the reduced value
+// and true from non-empty slice.
+//
+// If slice is empty, returns zero value of type T and false. Panics on nil
+// reduce function.
+func Reduce[T any](slice []T, reduceFn func(T, T) T) (T, bool) {
+	if len(slice) == 0 {
+		return zeroValue[T](), false
+	}
+	return Fold(slice[1:], slice[0], reduceFn), true
+}
+
+// Creates a new slice with every occurrence of `value` removed. Order of the
+// remaining elements is preserved.
+//
+// Returns nil on nil slice.
+func RemoveAll[T comparable](slice []T, value T) []T {
+	return Filter(slice, func(val T) bool { return val != value })
+}
+
+// Remove every occurrence of `value` from a slice in place and return the
+// number of removed elements. Order of the remaining elements is preserved.
+// Slice is passed as pointer because its length could be modified.
+//
+// Does not allocate. Returns zero on nil slice pointer.
+func RemoveAllInPlace[T comparable](slicep *[]T, value T) int {
+	// Pointer could be nil.
+	if slicep == nil {
+		return 0
+	}
+	oldLen := len(*slicep)
+	FilterInPlace(slicep, func(val T) bool { return val != value })
+	return oldLen - len(*slicep)
+}
+
+// Creates a new slice with the element at index `idx` removed. Original slice
+// is not modified.
+//
+// Panics if `idx` is not a valid index of the slice.
+func RemoveAt[T any](slice []T, idx int) []T {
+	if idx < 0 || idx >= len(slice) {
+		panic("sliceutils: RemoveAt index out of range")
+	}
+	return RemoveRange(slice, idx, idx+1)
+}
+
+// Creates a new slice with elements in the half-open index range from `start`
+// to `end` removed. Original slice is not modified.
+//
+// Returns nil on nil slice. Panics if `start` is negative, `end` is greater
+// than the length of the slice or `start` is greater than `end`.
+func RemoveRange[T any](slice []T, start, end int) []T {
+	if start < 0 || end > len(slice) || start > end {
+		panic("sliceutils: RemoveRange index out of range")
+	}
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	outSlice := make([]T, 0, len(slice)-(end-start))
+	outSlice = append(outSlice, slice[:start]...)
+	return append(outSlice, slice[end:]...)
+}
+
+// Creates a new slice with the first occurrence of `value` removed. Order of
+// the remaining elements is preserved. Returns a copy of the slice if it does
+// not contain the value.
+//
+// Returns nil on nil slice.
+func RemoveValue[T comparable](slice []T, value T) []T {
+	idx, found := IndexOf(slice, value)
+	if !found {
+		return Map(slice, func(val T) T { return val })
+	}
+	return RemoveAt(slice, idx)
+}
+
+// Creates a new slice by concatenating `count` copies of the slice, e.g.
+// `[1, 2]` repeated three times is `[1, 2, 1, 2, 1, 2]`. Resulting slice is
+// allocated once.
+//
+// Returns empty slice if `count` is not positive or on nil slice.
+func Repeat[T any](slice []T, count int) []T {
+	if count <= 0 {
+		return make([]T, 0)
+	}
+	outSlice := make([]T, 0, len(slice)*count)
+	for i := 0; i < count; i++ {
+		outSlice = append(outSlice, slice...)
+	}
+	return outSlice
+}
+
+// Replaces all non-overlapping occurrences of the contiguous subsequence `old`
+// with `new`. Occurrences are searched from the start of the slice. Returns a
+// new slice and does not modify the arguments.
+//
+// Empty `old` matches nothing and returns a copy of the slice. Returns nil on
+// nil slice.
+func ReplaceAllSubslice[T comparable](slice, old, new []T) []T {
+	return replaceSubslice(slice, old, new, -1)
+}
+
+// Replaces the first occurrence of the contiguous subsequence `old` with
+// `new`. Returns a new slice and does not modify the arguments.
+//
+// Empty `old` matches nothing and returns a copy of the slice. Returns nil on
+// nil slice.
+func ReplaceSubslice[T comparable](slice, old, new []T) []T {
+	return replaceSubslice(slice, old, new, 1)
+}
+
+// Reverses the order of elements in a slice.
+//
+// Returns nil on nil slice.
+func Reverse[T any](slice []T) []T {
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	i := len(slice) - 1
+	outSlice := make([]T, len(slice))
+	for _, val := range slice {
+		outSlice[i] = val
+		i--
+	}
+	return outSlice
+}
+
+// Reverses the order of elements in a slice.
+//
+// Does not allocate.
+func ReverseInPlace[T any](slice []T) {
+	l := len(slice)
+	for i := 0; i < l/2; i++ {
 		slice[i], slice[l-1-i] = slice[l-1-i], slice[i]
 	}
 }
 
+// Reduces each sliding window of `size` consecutive elements into a single
+// value by maintaining a state incrementally. State is created with `init` and
+// the first window is added to it element by element. For each next window,
+// the entering element is added with `add` and the leaving element removed
+// with `remove`. Result for each window is computed from the state with
+// `result`. This allows computing e.g. moving sums in linear time regardless
+// of the window size.
+//
+// Returns nil on nil slice. Returns empty slice if `size` is larger than the
+// length of the slice. Panics if `size` is not positive or on nil functions.
+func RollingReduce[T, S, U any](slice []T, size int, init func() S, add func(S, T) S, remove func(S, T) S, result func(S) U) []U {
+	if size <= 0 {
+		panic("sliceutils: non-positive RollingReduce size")
+	}
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	if size > len(slice) {
+		return make([]U, 0)
+	}
+	outSlice := make([]U, 0, len(slice)-size+1)
+	state := Fold(slice[:size], init(), add)
+	outSlice = append(outSlice, result(state))
+	for i := size; i < len(slice); i++ {
+		state = remove(add(state, slice[i]), slice[i-size])
+		outSlice = append(outSlice, result(state))
+	}
+	return outSlice
+}
+
+// Randomly selects `n` distinct elements of the slice, i.e. samples without
+// replacement. Every element is equally likely to be selected and the sample
+// is in random order. Randomness is taken from the given random source which
+// allows reproducible samples.
+//
+// Returns a shuffled copy of the whole slice if `n` is at least the length of
+// the slice and empty slice if `n` is not positive. Returns nil on nil slice.
+// Panics on nil random source.
+func Sample[T any](slice []T, n int, r *rand.Rand) []T {
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	n = clampLen(n, len(slice))
+	pool := make([]T, len(slice))
+	copy(pool, slice)
+	// Partial Fisher-Yates shuffle; only the first `n` positions are settled.
+	for i := 0; i < n; i++ {
+		j := i + r.Intn(len(pool)-i)
+		pool[i], pool[j] = pool[j], pool[i]
+	}
+	return pool[:n:n]
+}
+
+// Randomly selects `n` elements of the slice and returns them as the sample,
+// and the elements which were not selected as the rest. Both are derived from
+// a single shuffle of a copy of the slice, so they are in random order.
+// Randomness is taken from the given random source.
+//
+// `n` is clamped between zero and the length of the slice. Returns nil slices
+// on nil slice. Panics on nil random source.
+func SamplePartition[T any](slice []T, n int, r *rand.Rand) (sample []T, rest []T) {
+	// Preserve nil.
+	if slice == nil {
+		return nil, nil
+	}
+	shuffled := Shuffle(slice, r)
+	n = clampLen(n, len(shuffled))
+	// Limit capacity so that appending to the sample cannot overwrite the rest.
+	return shuffled[:n:n], shuffled[n:]
+}
+
+// Creates a copy of the slice where elements are in random order. Randomness is
+// taken from the given random source which allows reproducible shuffles.
+//
+// Returns nil on nil slice. Panics on nil random source.
+func Shuffle[T any](slice []T, r *rand.Rand) []T {
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	outSlice := make([]T, len(slice))
+	copy(outSlice, slice)
+	r.Shuffle(len(outSlice), func(i, j int) {
+		outSlice[i], outSlice[j] = outSlice[j], outSlice[i]
+	})
+	return outSlice
+}
+
+// Creates a copy of the slice where elements are in random order determined by
+// the seed. The same slice and seed always produce the same order.
+//
+// Returns nil on nil slice.
+func ShuffleSeeded[T any](slice []T, seed int64) []T {
+	return Shuffle(slice, rand.New(rand.NewSource(seed)))
+}
+
+// Creates a sorted copy of the slice using given comparison function. For
+// ascending order, pass a comparison function which returns true when left is
+// less than right. Sort is stable, i.e. equal elements keep their order.
+//
+// Returns nil on nil slice. Panics on nil comparison function.
+func SortBy[T any](slice []T, lessFn func(T, T) bool) []T {
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	outSlice := make([]T, len(slice))
+	copy(outSlice, slice)
+	SortInPlace(outSlice, lessFn)
+	return outSlice
+}
+
+// Sorts the slice in place using given comparison function. For ascending
+// order, pass a comparison function which returns true when left is less than
+// right. Sort is stable, i.e. equal elements keep their order.
+//
+// Does not allocate beyond what sorting requires. Panics on nil comparison
+// function.
+func SortInPlace[T any](slice []T, lessFn func(T, T) bool) {
+	sort.SliceStable(slice, func(i, j int) bool {
+		return lessFn(slice[i], slice[j])
+	})
+}
+
+// Creates a difference set from two sets sorted by the comparison function.
+// Resulting set will contain elements from left set which are not in the right
+// set. Computed with a linear merge without allocating a map. Elements are
+// considered equal when neither is less than the other. Result is undefined if
+// the sets are not sorted.
+//
+// Returns nil on nil left set. Panics on nil comparison function.
+func SortedDifferenceBy[T any](lhs, rhs []T, lessFn func(T, T) bool) []T {
+	// Preserve nil.
+	if lhs == nil {
+		return nil
+	}
+	outSlice := make([]T, 0)
+	i, j := 0, 0
+	for i < len(lhs) && j < len(rhs) {
+		switch {
+		case lessFn(lhs[i], rhs[j]):
+			outSlice = append(outSlice, lhs[i])
+			i++
+		case lessFn(rhs[j], lhs[i]):
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+	return append(outSlice, lhs[i:]...)
+}
+
+// Creates an intersection set from two sets sorted by the comparison function.
+// Resulting set will contain elements which are in left and right sets. Equal
+// elements are taken from the left set. Computed with a linear merge without
+// allocating a map. Elements are considered equal when neither is less than
+// the other. Result is undefined if the sets are not sorted.
+//
+// Returns nil if both sets are nil. Panics on nil comparison function.
+func SortedIntersectionBy[T any](lhs, rhs []T, lessFn func(T, T) bool) []T {
+	// Preserve nil.
+	if lhs == nil && rhs == nil {
+		return nil
+	}
+	outSlice := make([]T, 0)
+	i, j := 0, 0
+	for i < len(lhs) && j < len(rhs) {
+		switch {
+		case lessFn(lhs[i], rhs[j]):
+			i++
+		case lessFn(rhs[j], lhs[i]):
+			j++
+		default:
+			outSlice = append(outSlice, lhs[i])
+			i++
+			j++
+		}
+	}
+	return outSlice
+}
+
+// Creates a union set from two sets sorted by the comparison function.
+// Resulting set will contain elements from both left and right sets in sorted
+// order. Equal elements are taken from the left set. Computed with a linear
+// merge without allocating a map. Elements are considered equal when neither
+// is less than the other. Result is undefined if the sets are not sorted.
+//
+// Returns nil if both sets are nil. Panics on nil comparison function.
+func SortedUnionBy[T any](lhs, rhs []T, lessFn func(T, T) bool) []T {
+	// Preserve nil.
+	if lhs == nil && rhs == nil {
+		return nil
+	}
+	outSlice := make([]T, 0, len(lhs)+len(rhs))
+	i, j := 0, 0
+	for i < len(lhs) && j < len(rhs) {
+		switch {
+		case lessFn(lhs[i], rhs[j]):
+			outSlice = append(outSlice, lhs[i])
+			i++
+		case lessFn(rhs[j], lhs[i]):
+			outSlice = append(outSlice, rhs[j])
+			j++
+		default:
+			outSlice = append(outSlice, lhs[i])
+			i++
+			j++
+		}
+	}
+	outSlice = append(outSlice, lhs[i:]...)
+	return append(outSlice, rhs[j:]...)
+}
+
+// Splits a slice into the longest prefix of elements satisfying the predicate
+// and the remaining elements in a single pass. Equivalent to calling TakeWhile
+// and DropWhile. Both slices share the backing array of the original slice.
+//
+// Returns nil slices on nil slice. Panics on nil predicate function.
+func SpanBy[T any](slice []T, predFn func(T) bool) ([]T, []T) {
+	prefix := TakeWhile(slice, predFn)
+	return prefix, slice[len(prefix):]
+}
+
+// Splits a slice into sub-slices separated by the separator value, like
+// strings.Split. Separators are not included in the sub-slices. Consecutive
+// separators, as well as a leading or trailing separator, produce empty
+// sub-slices. Sub-slices share the backing array of the original slice.
+//
+// Returns a single sub-slice containing the whole slice if it does not
+// contain the separator. Returns nil on nil slice.
+func SplitBy[T comparable](slice []T, sep T) [][]T {
+	return SplitFunc(slice, func(val T) bool { return val == sep })
+}
+
+// Splits a slice into segments of consecutive elements with equal keys. A new
+// segment is started whenever the key returned by the key function differs
+// from the key of the previous element. Segments share the backing array of
+// the original slice.
+//
+// Returns nil on nil slice. Panics on nil key function.
+func SplitByKeyChange[T any, K comparable](slice []T, keyFn func(T) K) [][]T {
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	outSlice := make([][]T, 0)
+	if len(slice) == 0 {
+		return outSlice
+	}
+	start := 0
+	prevKey := keyFn(slice[0])
+	for i := 1; i < len(slice); i++ {
+		key := keyFn(slice[i])
+		if key != prevKey {
+			// Limit capacity so that appending to a segment cannot overwrite
+			// the next one.
+			outSlice = append(outSlice, slice[start:i:i])
+			start = i
+			prevKey = key
+		}
+	}
+	return append(outSlice, slice[start:len(slice):len(slice)])
+}
+
+// Splits a slice into sub-slices at elements for which the separator function
+// returns true. Separator elements are not included in the sub-slices.
+// Consecutive separators, as well as a leading or trailing separator, produce
+// empty sub-slices. Sub-slices share the backing array of the original slice.
+//
+// Returns a single sub-slice containing the whole slice if it does not
+// contain separators. Returns nil on nil slice. Panics on nil separator
+// function.
+func SplitFunc[T any](slice []T, isSep func(T) bool) [][]T {
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	outSlice := make([][]T, 0)
+	start := 0
+	for i, val := range slice {
+		if isSep(val) {
+			// Limit capacity so that appending to a sub-slice cannot
+			// overwrite the separator.
+			outSlice = append(outSlice, slice[start:i:i])
+			start = i + 1
+		}
+	}
+	return append(outSlice, slice[start:len(slice):len(slice)])
+}
+
+// Subtracts elements of the right slice from elements of the left slice by
+// their indices up to the length of the shorter slice.
+//
+// Returns nil if either slice is nil.
+func Sub[T Number](lhs, rhs []T) []T {
+	return ZipWith(lhs, rhs, func(a, b T) T { return a - b })
+}
+
 // Creates a symmetric difference set from two slices. Resulting slice will
 // contain elements from left and right sets which are not in both i.e. in
 // their intersection.
@@ -474,33 +2225,498 @@ func SymmetricDifference[T comparable](lhs, rhs []T) []T {
 	return append(Difference(lhs, rhs), Difference(rhs, lhs)...)
 }
 
+// Returns all elements except the first one. Resulting slice shares the
+// backing array of the original slice, so modifications are visible in both.
+//
+// Returns empty slice on slices with less than two elements. Returns nil on
+// nil slice.
+func Tail[T any](slice []T) []T {
+	return Drop(slice, 1)
+}
+
+// Returns the first `n` elements. Resulting slice shares the backing array of
+// the original slice, so modifications are visible in both.
+//
+// `n` is clamped between zero and the length of the slice. Returns nil on nil
+// slice.
+func Take[T any](slice []T, n int) []T {
+	n = clampLen(n, len(slice))
+	// Limit capacity so that appending to the prefix cannot overwrite the
+	// original slice.
+	return slice[:n:n]
+}
+
+// Returns the longest prefix of elements for which the predicate function
+// returns true. Stops at the first element for which the predicate returns
+// false. Resulting slice shares the backing array of the original slice.
+//
+// Returns nil on nil slice. Panics on nil predicate function.
+func TakeWhile[T any](slice []T, predFn func(T) bool) []T {
+	n := 0
+	for n < len(slice) && predFn(slice[n]) {
+		n++
+	}
+	// Limit capacity so that appending to the prefix cannot overwrite the
+	// original slice.
+	return slice[:n:n]
+}
+
+// Returns a closed channel containing the slice elements in order. Channel is
+// buffered to the length of the slice so that all elements are sent without
+// starting a goroutine; there is nothing left running if the receiver stops
+// early.
+//
+// Returns closed empty channel on nil slice.
+func ToChannel[T any](slice []T) <-chan T {
+	ch := make(chan T, len(slice))
+	for _, val := range slice {
+		ch <- val
+	}
+	close(ch)
+	return ch
+}
+
+// Returns the `n` largest elements of the slice in descending order using given
+// comparison function, which should return true when left is less than right.
+// Equal elements keep their original order. Selection uses a bounded heap, so
+// the time complexity is O(len(slice) * log(n)) instead of sorting the whole
+// slice.
+//
+// Returns all elements in descending order if `n` is at least the length of
+// the slice and empty slice if `n` is not positive. Returns nil on nil slice.
+// Panics on nil comparison function.
+func TopN[T any](slice []T, n int, lessFn func(T, T) bool) []T {
+	return selectFirstN(slice, n, func(a, b T) bool { return lessFn(b, a) })
+}
+
+// Transposes a matrix by swapping its rows and columns, i.e. element at
+// `matrix[r][c]` is placed at `[c][r]` of the resulting matrix. Resulting
+// matrix does not share memory with the original.
+//
+// Ragged matrices, where rows have differing lengths, are transposed up to
+// the length of the shortest row; elements beyond it are dropped.
+//
+// Returns nil on nil matrix.
+func Transpose[T any](matrix [][]T) [][]T {
+	// Preserve nil.
+	if matrix == nil {
+		return nil
+	}
+	cols := 0
+	for i, row := range matrix {
+		if i == 0 || len(row) < cols {
+			cols = len(row)
+		}
+	}
+	return Generate(cols, func(c int) []T {
+		return Generate(len(matrix), func(r int) T { return matrix[r][c] })
+	})
+}
+
+// Maps each slice value with a fallible mapping function. Resulting slice
+// contains values returned by the mapping function while preserving order.
+// Mapping stops at the first error, which is returned with a nil slice.
+//
+// Returns nil and no error on nil slice. Panics on nil mapping function.
+func TryMap[T, U any](slice []T, mapFn func(T) (U, error)) ([]U, error) {
+	// Preserve nil.
+	if slice == nil {
+		return nil, nil
+	}
+	// Reserve capacity eagerly to allocate only once.
+	outSlice := make([]U, 0, len(slice))
+	for _, val := range slice {
+		mapped, err := mapFn(val)
+		if err != nil {
+			return nil, err
+		}
+		outSlice = append(outSlice, mapped)
+	}
+	return outSlice, nil
+}
+
+// Builds a slice from a seed state by repeatedly calling the step function. The
+// step function returns the next element, the next state and whether to
+// continue. Elements are appended until the step function returns false, in
+// which case the returned element is discarded. This is the dual of Fold.
+//
+// Returns empty slice if the first step returns false. Panics on nil step
+// function.
+func Unfold[T, S any](seed S, stepFn func(S) (T, S, bool)) []T {
+	outSlice := make([]T, 0)
+	state := seed
+	for {
+		val, next, ok := stepFn(state)
+		if !ok {
+			return outSlice
+		}
+		outSlice = append(outSlice, val)
+		state = next
+	}
+}
+
 // Creates a union set from two slices. Resulting set will contain elements
 // from both left and right sets.
 //
 // Returns nil if both sets are nil.
 func Union[T comparable](lhs, rhs []T) []T {
-	outSlice := append(lhs, rhs...)
+	// Preserve nil.
+	if lhs == nil && rhs == nil {
+		return nil
+	}
+	// Copy into a new slice as appending to left set could overwrite elements
+	// beyond its length in the backing array.
+	outSlice := make([]T, 0, len(lhs)+len(rhs))
+	outSlice = append(outSlice, lhs...)
+	outSlice = append(outSlice, rhs...)
+	DeduplicateInPlace(&outSlice)
+	return outSlice
+}
+
+// Creates a union set from two slices using keys derived with the key function
+// as element identities. Resulting set will contain elements from both left
+// and right sets. When elements from both sets have the same key, the element
+// of the left set is kept.
+//
+// Returns nil if both sets are nil. Panics on nil key function if either set
+// is non-empty.
+func UnionBy[T any, K comparable](lhs, rhs []T, keyFn func(T) K) []T {
+	// Preserve nil.
+	if lhs == nil && rhs == nil {
+		return nil
+	}
+	keys := make(map[K]struct{})
+	outSlice := make([]T, 0)
+	for _, slice := range [][]T{lhs, rhs} {
+		for _, val := range slice {
+			key := keyFn(val)
+			if _, exists := keys[key]; !exists {
+				keys[key] = struct{}{}
+				outSlice = append(outSlice, val)
+			}
+		}
+	}
+	return outSlice
+}
+
+// Creates a union set from multiple slices. Resulting set will contain
+// elements from all sets, without duplicates and in the order they are first
+// seen going from the first set to the last.
+//
+// Returns nil on no slices or if all slices are nil.
+func UnionN[T comparable](slices ...[]T) []T {
+	if All(slices, func(slice []T) bool { return slice == nil }) {
+		return nil
+	}
+	outSlice := Join(slices...)
 	DeduplicateInPlace(&outSlice)
 	return outSlice
 }
 
+// Reduces each sliding window of `size` consecutive elements into a single
+// value with the reduce function. Resulting slice contains one value per
+// window in order. Windows share the backing array of the original slice, so
+// the reduce function should neither modify nor retain them.
+//
+// Returns nil on nil slice. Returns empty slice if `size` is larger than the
+// length of the slice. Panics if `size` is not positive or on nil reduce
+// function.
+func WindowReduce[T, U any](slice []T, size int, reduceFn func([]T) U) []U {
+	if size <= 0 {
+		panic("sliceutils: non-positive window size")
+	}
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+	if size > len(slice) {
+		return make([]U, 0)
+	}
+	outSlice := make([]U, 0, len(slice)-size+1)
+	for i := size; i <= len(slice); i++ {
+		outSlice = append(outSlice, reduceFn(slice[i-size:i:i]))
+	}
+	return outSlice
+}
+
+// Returns every sliding window of `size` consecutive elements in order. For
+// example windows of size 2 of `[1, 2, 3]` are `[1, 2]` and `[2, 3]`. Windows
+// share the backing array of the original slice, so modifying an element is
+// visible in the original slice and in all windows containing it.
+//
+// Returns nil on nil slice. Returns empty slice if `size` is larger than the
+// length of the slice. Panics if `size` is not positive.
+func Windows[T any](slice []T, size int) [][]T {
+	// Windows are the identity reduction of each window.
+	return WindowReduce(slice, size, func(window []T) []T { return window })
+}
+
+// Pairs elements of two slices by their indices up to the length of the
+// longer slice. Once the shorter slice is exhausted, its elements are replaced
+// by the given default value.
+//
+// Returns nil if both slices are nil.
+func ZipLongest[T, U any](a []T, b []U, defaultA T, defaultB U) []Pair[T, U] {
+	// Preserve nil.
+	if a == nil && b == nil {
+		return nil
+	}
+	length := MaxOf(len(a), len(b))
+	return Generate(length, func(idx int) Pair[T, U] {
+		pair := Pair[T, U]{First: defaultA, Second: defaultB}
+		if idx < len(a) {
+			pair.First = a[idx]
+		}
+		if idx < len(b) {
+			pair.Second = b[idx]
+		}
+		return pair
+	})
+}
+
+// Combines elements of two slices by their indices with a zip function up to
+// the length of the shorter slice. Resulting slice contains values returned by
+// the zip function in order.
+//
+// Returns nil if either slice is nil. Panics on nil zip function.
+func ZipWith[T, U, V any](lhs []T, rhs []U, f func(T, U) V) []V {
+	// Preserve nil.
+	if lhs == nil || rhs == nil {
+		return nil
+	}
+	return Generate(MinOf(len(lhs), len(rhs)), func(idx int) V {
+		return f(lhs[idx], rhs[idx])
+	})
+}
+
 ////////////////////////
 // PARALLEL FUNCTIONS //
 ////////////////////////
 
+// Option for configuring the parallel functions which accept options.
+type ParOption func(*parConfig)
+
+// Sets the number of goroutines the work is divided between. Counts below one
+// are treated as one. Defaults to the number of logical processors.
+func WithWorkers(n int) ParOption {
+	return func(config *parConfig) {
+		config.workers = n
+	}
+}
+
+// Splits the slice into chunks of `chunkSize` elements and maps each chunk
+// with a mapping function in parallel. Resulting slice contains the mapped
+// chunks concatenated in the original order. Number of goroutines is limited
+// by the number of logical processors. Unlike ParMap, the mapping function
+// processes whole chunks, which suits batch APIs. Last chunk may be shorter
+// than `chunkSize`.
+//
+// Returns nil on nil slice. Panics if `chunkSize` is not positive or on nil
+// mapping function.
+func ParChunkMap[T, U any](slice []T, chunkSize int, mapFn func(chunk []T) []U) []U {
+	return ParChunkMapWith(slice, chunkSize, mapFn)
+}
+
+// Same as ParChunkMap but accepts options. Number of goroutines is set with
+// WithWorkers and is further limited by the number of chunks.
+//
+// Returns nil on nil slice. Panics if `chunkSize` is not positive or on nil
+// mapping function.
+func ParChunkMapWith[T, U any](slice []T, chunkSize int, mapFn func(chunk []T) []U, opts ...ParOption) []U {
+	if chunkSize <= 0 {
+		panic("sliceutils: non-positive ParChunkMap chunk size")
+	}
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+
+	// Divide chunks instead of elements between goroutines.
+	numChunks := (len(slice) + chunkSize - 1) / chunkSize
+	divs := MinOf(newParConfig(opts).workers, numChunks)
+
+	// Mapped chunks are stored by their index to preserve order.
+	mappedChunks := make([][]U, numChunks)
+
+	// Create a waitgroup for waiting goroutines to finish.
+	var wg sync.WaitGroup
+	wg.Add(divs)
+
+	if divs > 0 {
+		sliceDivGen := newSliceDivGen(numChunks, divs)
+		for divIdx := 0; divIdx < divs; divIdx++ {
+			// Start goroutine for mapping a range of chunks.
+			go func(divIdx int) {
+				// Notify goroutine has finished mapping in the end.
+				defer wg.Done()
+
+				offset, length := sliceDivGen.get(divIdx)
+				for chunkIdx := offset; chunkIdx < offset+length; chunkIdx++ {
+					start := chunkIdx * chunkSize
+					end := MinOf(start+chunkSize, len(slice))
+					mappedChunks[chunkIdx] = mapFn(slice[start:end:end])
+				}
+			}(divIdx)
+		}
+	}
+	// Wait until all goroutines have finished.
+	wg.Wait()
+
+	return Flatten(mappedChunks)
+}
+
+// Filter values in a slice by filter function and divides the slice by the
+// number of logical processors to evenly distribute work. Resulting slice will
+// contain values for which the filter function returns true in their original
+// order. Useful when the filter function is expensive.
+//
+// Returns nil on nil slice. Panics on nil filter function.
+func ParFilter[T any](slice []T, filterFn func(T) bool) []T {
+	return ParFilterWith(slice, filterFn)
+}
+
+// Same as ParFilter but takes options for configuring the parallelism, e.g.
+// the number of goroutines with WithWorkers. Without options, slice is divided
+// by the number of logical processors.
+//
+// Returns nil on nil slice. Panics on nil filter function.
+func ParFilterWith[T any](slice []T, filterFn func(T) bool, opts ...ParOption) []T {
+	// Preserve nil.
+	if slice == nil {
+		return nil
+	}
+
+	// Create slice division generator based on the length of the slice and the number of divisions.
+	divs := newParConfig(opts).divsFor(len(slice))
+	sliceDivGen := newSliceDivGen(len(slice), divs)
+
+	// Filtered values of each division are concatenated in division order.
+	filteredDivs := make([][]T, divs)
+
+	// Create a waitgroup for waiting goroutines to finish.
+	var wg sync.WaitGroup
+	wg.Add(divs)
+
+	// Loop all divisions
+	for divIdx := 0; divIdx < divs; divIdx++ {
+		// Start goroutine for filtering a sub-slice.
+		go func(divIdx int) {
+			// Notify goroutine has finished filtering in the end.
+			defer wg.Done()
+
+			// Get division specific offset and length for the sub-slice.
+			offset, length := sliceDivGen.get(divIdx)
+			start, end := offset, offset+length
+
+			// Filter.
+			filteredDivs[divIdx] = Filter(slice[start:end], filterFn)
+		}(divIdx)
+	}
+	// Wait until all goroutines have finished.
+	wg.Wait()
+
+	return Flatten(filteredDivs)
+}
+
 // Maps each slice value with a mapping function and divides the slice by the
 // number of logical processors to evenly distribute work.
 //
 // Returns nil on nil slice. Panics on nil mapping function.
 func ParMap[T, U any](slice []T, mapFn func(T) U) []U {
+	return ParMapWith(slice, mapFn)
+}
+
+// Maps each slice value with a fallible mapping function and divides the
+// slice by the number of workers to evenly distribute work. Number of workers
+// defaults to the number of logical processors and can be set with
+// WithWorkers. The mapping function is given a context derived from `ctx`,
+// which is cancelled when any mapping fails so that other goroutines can stop
+// early. On success, resulting slice contains mapped values in their original
+// order.
+//
+// Returns nil and the first error if any mapping fails, or nil and the context
+// error if `ctx` is done before all values have been mapped. Returns nil and no
+// error on nil slice. Panics on nil mapping function.
+func ParMapErr[T, U any](ctx context.Context, slice []T, mapFn func(context.Context, T) (U, error), opts ...ParOption) ([]U, error) {
+	// Preserve nil.
+	if slice == nil {
+		return nil, nil
+	}
+
+	// Derived context is cancelled on the first error to stop other goroutines.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Create slice division generator based on the length of the slice and the number of divisions.
+	sliceLen := len(slice)
+	divs := newParConfig(opts).divsFor(sliceLen)
+	sliceDivGen := newSliceDivGen(sliceLen, divs)
+
+	// Pre-sized result slice.
+	resultSlice := make([]U, sliceLen)
+
+	// Only the first error is reported.
+	var firstErr error
+	var errOnce sync.Once
+	setErr := func(err error) {
+		errOnce.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	// Create a waitgroup for waiting goroutines to finish.
+	var wg sync.WaitGroup
+	wg.Add(divs)
+
+	// Loop all divisions
+	for divIdx := 0; divIdx < divs; divIdx++ {
+		// Start goroutine for mapping a sub-slice.
+		go func(divIdx int) {
+			// Notify goroutine has finished mapping in the end.
+			defer wg.Done()
+
+			// Get division specific offset and length for the sub-slice.
+			offset, length := sliceDivGen.get(divIdx)
+
+			// Map until done or failed.
+			for i := offset; i < offset+length; i++ {
+				if err := ctx.Err(); err != nil {
+					setErr(err)
+					return
+				}
+				mapped, err := mapFn(ctx, slice[i])
+				if err != nil {
+					setErr(err)
+					return
+				}
+				resultSlice[i] = mapped
+			}
+		}(divIdx)
+	}
+	// Wait until all goroutines have finished.
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return resultSlice, nil
+}
+
+// Same as ParMap but takes options for configuring the parallelism, e.g. the
+// number of goroutines with WithWorkers. Without options, slice is divided by
+// the number of logical processors.
+//
+// Returns nil on nil slice. Panics on nil mapping function.
+func ParMapWith[T, U any](slice []T, mapFn func(T) U, opts ...ParOption) []U {
 	// Preserve nil.
 	if slice == nil {
 		return nil
 	}
 
 	// Create slice division generator based on the length of the slice and the number of divisions.
-	divs := runtime.NumCPU()
 	sliceLen := len(slice)
+	divs := newParConfig(opts).divsFor(sliceLen)
 	sliceDivGen := newSliceDivGen(sliceLen, divs)
 
 	// Pre-sized result slice.
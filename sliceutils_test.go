@@ -1,13 +1,38 @@
 package sliceutils
 
 import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"math"
+	"math/rand"
+	"runtime"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 )
 
+func TestAdd(t *testing.T) {
+	t.Run("Add slices of equal lengths", func(t *testing.T) {
+		assert.Equal(t, []int{5, 7, 9}, Add([]int{1, 2, 3}, []int{4, 5, 6}))
+	})
+
+	t.Run("Add up to the shorter length", func(t *testing.T) {
+		assert.Equal(t, []float64{1.5, 2.5}, Add([]float64{1, 2, 3}, []float64{0.5, 0.5}))
+	})
+
+	t.Run("Return empty slice on empty slice", func(t *testing.T) {
+		assert.Equal(t, []int{}, Add([]int{}, []int{1}))
+	})
+
+	t.Run("Return nil if either slice is nil", func(t *testing.T) {
+		assert.Nil(t, Add(nil, []int{1}))
+	})
+}
+
 func TestAll(t *testing.T) {
 	t.Run("All elements evaluate to true", func(t *testing.T) {
 		slice := []int{1, 4, 6, 2, 3, 7}
@@ -69,285 +94,1696 @@ func TestAreDisjoint(t *testing.T) {
 	})
 }
 
-func TestContains(t *testing.T) {
-	t.Run("Slice contains element", func(t *testing.T) {
-		slice := []int{1, 2, 3, 4}
-		contains := Contains(slice, 2)
-		assert.True(t, contains)
+func TestArgMax(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	t.Run("Return index of max", func(t *testing.T) {
+		slice := []int{3, 9, 1, 4}
+		idx, ok := ArgMax(slice, less)
+		assert.Equal(t, 1, idx)
+		assert.True(t, ok)
 	})
 
-	t.Run("Slice does not contain element", func(t *testing.T) {
-		slice := []int{1, 2, 3, 4}
-		contains := Contains(slice, 5)
-		assert.False(t, contains)
+	t.Run("Return index of first occurrence on ties", func(t *testing.T) {
+		slice := []int{3, 9, 1, 9}
+		idx, ok := ArgMax(slice, less)
+		assert.Equal(t, 1, idx)
+		assert.True(t, ok)
 	})
 
-	t.Run("Return false on nil slice", func(t *testing.T) {
+	t.Run("Return zero and false on empty slice", func(t *testing.T) {
+		idx, ok := ArgMax([]int{}, less)
+		assert.Equal(t, 0, idx)
+		assert.False(t, ok)
+	})
+
+	t.Run("Return zero and false on nil slice", func(t *testing.T) {
 		var slice []int = nil
-		contains := Contains(slice, 1)
-		assert.False(t, contains)
+		idx, ok := ArgMax(slice, less)
+		assert.Equal(t, 0, idx)
+		assert.False(t, ok)
 	})
 }
 
-func TestCount(t *testing.T) {
-	t.Run("Count zeros", func(t *testing.T) {
-		slice := []int{1, 2, 3, 4, 0, 1, 4, 0, 0, 12, 3, 5, 7, 1}
-		count := Count(slice, func(i int) bool { return i == 0 })
-		assert.Equal(t, 3, count)
+func TestArgMin(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	t.Run("Return index of min", func(t *testing.T) {
+		slice := []int{3, 9, 1, 4}
+		idx, ok := ArgMin(slice, less)
+		assert.Equal(t, 2, idx)
+		assert.True(t, ok)
 	})
 
-	t.Run("Return zero on nil slice", func(t *testing.T) {
+	t.Run("Return index of first occurrence on ties", func(t *testing.T) {
+		slice := []int{3, 1, 9, 1}
+		idx, ok := ArgMin(slice, less)
+		assert.Equal(t, 1, idx)
+		assert.True(t, ok)
+	})
+
+	t.Run("Return zero and false on empty slice", func(t *testing.T) {
+		idx, ok := ArgMin([]int{}, less)
+		assert.Equal(t, 0, idx)
+		assert.False(t, ok)
+	})
+
+	t.Run("Return zero and false on nil slice", func(t *testing.T) {
 		var slice []int = nil
-		count := Count(slice, func(i int) bool { return i == 0 })
-		assert.Equal(t, 0, count)
+		idx, ok := ArgMin(slice, less)
+		assert.Equal(t, 0, idx)
+		assert.False(t, ok)
 	})
 }
 
-func TestDeduplicate(t *testing.T) {
-	t.Run("Slice with duplicates", func(t *testing.T) {
-		slice := []int{1, 2, 3, 2}
-		depupped := Deduplicate(slice)
-		assert.Equal(t, []int{1, 2, 3}, depupped)
+func TestArgSortBy(t *testing.T) {
+	t.Run("Return sorting permutation", func(t *testing.T) {
+		slice := []int{30, 10, 20}
+		indices := ArgSortBy(slice, func(a, b int) bool { return a < b })
+		assert.Equal(t, []int{1, 2, 0}, indices)
+		assert.Equal(t, []int{30, 10, 20}, slice)
 	})
 
-	t.Run("Slice without duplicates", func(t *testing.T) {
-		slice := []int{1, 2, 3}
-		depupped := Deduplicate(slice)
-		assert.Equal(t, []int{1, 2, 3}, depupped)
+	t.Run("Reorder aligned slice", func(t *testing.T) {
+		ages := []int{42, 7, 19}
+		names := []string{"foo", "bar", "baz"}
+		indices := ArgSortBy(ages, func(a, b int) bool { return a < b })
+		sorted := Map(indices, func(i int) string { return names[i] })
+		assert.Equal(t, []string{"bar", "baz", "foo"}, sorted)
+	})
+
+	t.Run("Keep order of equal elements", func(t *testing.T) {
+		slice := []string{"bb", "a", "cc", "d"}
+		indices := ArgSortBy(slice, func(a, b string) bool { return len(a) < len(b) })
+		assert.Equal(t, []int{1, 3, 0, 2}, indices)
 	})
 
 	t.Run("Return nil on nil slice", func(t *testing.T) {
 		var slice []int = nil
-		depupped := Deduplicate(slice)
-		assert.Nil(t, depupped)
+		indices := ArgSortBy(slice, func(a, b int) bool { return a < b })
+		assert.Nil(t, indices)
 	})
 }
 
-func TestDeduplicateInPlace(t *testing.T) {
-	t.Run("Slice with duplicates", func(t *testing.T) {
-		slice := []int{1, 2, 3, 2}
-		DeduplicateInPlace(&slice)
-		assert.Equal(t, []int{1, 2, 3}, slice)
+func TestAssociate(t *testing.T) {
+	t.Run("Map strings to their lengths", func(t *testing.T) {
+		slice := []string{"foo", "hello", ""}
+		lengths := Associate(slice, func(s string) (string, int) { return s, len(s) })
+		assert.Equal(t, map[string]int{"foo": 3, "hello": 5, "": 0}, lengths)
 	})
 
-	t.Run("Slice without duplicates", func(t *testing.T) {
-		slice := []int{1, 2, 3}
-		DeduplicateInPlace(&slice)
-		assert.Equal(t, []int{1, 2, 3}, slice)
+	t.Run("Later values overwrite on key collision", func(t *testing.T) {
+		slice := []string{"foo", "bar", "hello"}
+		byLength := Associate(slice, func(s string) (int, string) { return len(s), strings.ToUpper(s) })
+		assert.Equal(t, map[int]string{3: "BAR", 5: "HELLO"}, byLength)
+	})
+
+	t.Run("Empty map on empty slice", func(t *testing.T) {
+		assoc := Associate([]int{}, func(i int) (int, int) { return i, i })
+		assert.Equal(t, map[int]int{}, assoc)
 	})
 
 	t.Run("Return nil on nil slice", func(t *testing.T) {
 		var slice []int = nil
-		DeduplicateInPlace(&slice)
-		assert.Nil(t, slice)
+		assoc := Associate(slice, func(i int) (int, int) { return i, i })
+		assert.Nil(t, assoc)
 	})
+}
 
-	t.Run("Do nothing on nil slice pointer", func(t *testing.T) {
-		DeduplicateInPlace[int](nil)
+func TestAssociateBy(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+
+	t.Run("Build lookup table by ID", func(t *testing.T) {
+		users := []user{{1, "foo"}, {2, "bar"}}
+		byID := AssociateBy(users, func(u user) int { return u.id })
+		assert.Equal(t, map[int]user{1: {1, "foo"}, 2: {2, "bar"}}, byID)
 	})
-}
 
-func TestDifference(t *testing.T) {
-	t.Run("Difference of two overlapping sets", func(t *testing.T) {
-		a := []int{1, 2, 3}
-		b := []int{3, 2, 6}
-		difference := Difference(a, b)
-		assert.Equal(t, []int{1}, difference)
+	t.Run("Last element wins on key collision", func(t *testing.T) {
+		users := []user{{1, "foo"}, {2, "bar"}, {1, "baz"}}
+		byID := AssociateBy(users, func(u user) int { return u.id })
+		assert.Equal(t, map[int]user{1: {1, "baz"}, 2: {2, "bar"}}, byID)
 	})
 
-	t.Run("Difference of two non-overlapping sets", func(t *testing.T) {
-		a := []int{1, 2, 3}
-		b := []int{5, 4, 6}
-		difference := Difference(a, b)
-		assert.Equal(t, []int{1, 2, 3}, difference)
+	t.Run("Empty map on empty slice", func(t *testing.T) {
+		byID := AssociateBy([]user{}, func(u user) int { return u.id })
+		assert.Equal(t, map[int]user{}, byID)
 	})
 
-	t.Run("Return nil when both sets are nil", func(t *testing.T) {
-		difference := Difference[int](nil, nil)
-		assert.Nil(t, difference)
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var users []user = nil
+		byID := AssociateBy(users, func(u user) int { return u.id })
+		assert.Nil(t, byID)
 	})
 }
 
-func TestFilter(t *testing.T) {
-	t.Run("Retain strings shorter than 4 characters", func(t *testing.T) {
-		slice := []string{"hello", "foo", "bar", "pointer", "cow", "F"}
-		filtered := Filter(slice, func(s string) bool { return len(s) < 4 })
-		assert.Equal(t, []string{"foo", "bar", "cow", "F"}, filtered)
+func TestAverage(t *testing.T) {
+	t.Run("Average of integers is not truncated", func(t *testing.T) {
+		avg, ok := Average([]int{1, 2, 3, 4})
+		assert.True(t, ok)
+		assert.Equal(t, 2.5, avg)
 	})
 
-	t.Run("Return nil on nil slice", func(t *testing.T) {
-		var slice []int = nil
-		flat := Filter(slice, func(i int) bool { return i < 4 })
-		assert.Nil(t, flat)
+	t.Run("Average of floats", func(t *testing.T) {
+		avg, ok := Average([]float32{0.5, 1.5, 4})
+		assert.True(t, ok)
+		assert.Equal(t, 2.0, avg)
 	})
-}
 
-func TestFilterInPlace(t *testing.T) {
-	t.Run("Retain strings shorter than 4 characters", func(t *testing.T) {
-		slice := []string{"hello", "foo", "bar", "pointer", "cow", "F"}
-		FilterInPlace(&slice, func(s string) bool { return len(s) < 4 })
-		assert.Equal(t, []string{"foo", "bar", "cow", "F"}, slice)
+	t.Run("Average of small integers does not overflow", func(t *testing.T) {
+		avg, ok := Average([]uint8{200, 250})
+		assert.True(t, ok)
+		assert.Equal(t, 225.0, avg)
 	})
 
-	t.Run("Do nothing on nil slice", func(t *testing.T) {
-		var slice []int = nil
-		FilterInPlace(&slice, func(i int) bool { return i < 4 })
-		assert.Nil(t, slice)
+	t.Run("Return false on empty slice", func(t *testing.T) {
+		_, ok := Average([]int{})
+		assert.False(t, ok)
 	})
 
-	t.Run("Do nothing on nil slice pointer", func(t *testing.T) {
-		FilterInPlace(nil, func(i int) bool { return i < 4 })
+	t.Run("Return false on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		avg, ok := Average(slice)
+		assert.False(t, ok)
+		assert.Equal(t, 0.0, avg)
 	})
 }
 
-func TestFilterMap(t *testing.T) {
-	ToPointer := func(s string) *string {
-		return &s
-	}
+func TestBinarySearchBy(t *testing.T) {
+	cmpInts := func(a, b int) int { return a - b }
+	slice := []int{1, 3, 3, 5, 7}
 
-	t.Run("Pointers to concrete types", func(t *testing.T) {
-		slice := []*string{
-			ToPointer("hello"),
-			nil,
-			ToPointer("foo"),
-			nil,
-			nil,
-			ToPointer("bar"),
+	t.Run("Find exact hits", func(t *testing.T) {
+		for i, val := range []int{1, 5, 7} {
+			idx, found := BinarySearchBy(slice, val, cmpInts)
+			assert.True(t, found)
+			assert.Equal(t, []int{0, 3, 4}[i], idx)
 		}
-		filterMapped := FilterMap(slice, func(s *string) (string, bool) {
-			if s == nil {
-				return "", false
-			}
-			return *s, true
-		})
-		assert.Equal(t, []string{"hello", "foo", "bar"}, filterMapped)
 	})
 
-	t.Run("Strings to their byte lengths if under 4", func(t *testing.T) {
-		slice := []string{"hello", "foo", "bar", "pointer", "cow", "F"}
-		filterMapped := FilterMap(slice, func(s string) (int, bool) {
-			slen := len(s)
-			return slen, slen < 4
-		})
-		assert.Equal(t, []int{3, 3, 3, 1}, filterMapped)
+	t.Run("Find first of equal elements", func(t *testing.T) {
+		idx, found := BinarySearchBy(slice, 3, cmpInts)
+		assert.True(t, found)
+		assert.Equal(t, 1, idx)
 	})
 
-	t.Run("Parse ints and discard failing strings", func(t *testing.T) {
-		slice := []string{"1", "2", "-3", "foo", "4", "n"}
-		filterMapped := FilterMap(slice, func(s string) (int, bool) {
-			value, err := strconv.Atoi(s)
-			return value, err == nil
-		})
-		assert.Equal(t, []int{1, 2, -3, 4}, filterMapped)
+	t.Run("Return insertion index at the start", func(t *testing.T) {
+		idx, found := BinarySearchBy(slice, 0, cmpInts)
+		assert.False(t, found)
+		assert.Equal(t, 0, idx)
 	})
 
-	t.Run("Return nil on nil slice", func(t *testing.T) {
-		var slice []int = nil
-		filterMapped := FilterMap(slice, func(i int) (int, bool) { return i, i > 0 })
-		assert.Nil(t, filterMapped)
+	t.Run("Return insertion index in the middle", func(t *testing.T) {
+		idx, found := BinarySearchBy(slice, 4, cmpInts)
+		assert.False(t, found)
+		assert.Equal(t, 3, idx)
 	})
-}
 
-func TestFindBy(t *testing.T) {
-	t.Run("Try to find and is found", func(t *testing.T) {
-		slice := []int{1, 2, 3, 4, 5, 6, 7, 8}
-		idx, found := FindBy(slice, func(i int) bool {
-			return i == 6
-		})
+	t.Run("Return insertion index at the end", func(t *testing.T) {
+		idx, found := BinarySearchBy(slice, 8, cmpInts)
+		assert.False(t, found)
 		assert.Equal(t, 5, idx)
-		assert.True(t, found)
 	})
 
-	t.Run("Try to find and is not found", func(t *testing.T) {
-		slice := []int{1, 2, 3, 4, 5, 6, 7, 8}
-		idx, found := FindBy(slice, func(i int) bool {
-			return i == 9
+	t.Run("Search by struct field", func(t *testing.T) {
+		type entry struct {
+			key   string
+			value int
+		}
+		entries := []entry{{"a", 1}, {"c", 2}, {"e", 3}}
+		idx, found := BinarySearchBy(entries, entry{key: "c"}, func(a, b entry) int {
+			return strings.Compare(a.key, b.key)
 		})
-		assert.Equal(t, 0, idx)
+		assert.True(t, found)
+		assert.Equal(t, 2, entries[idx].value)
+	})
+
+	t.Run("Return zero and false on empty slice", func(t *testing.T) {
+		idx, found := BinarySearchBy([]int{}, 1, cmpInts)
 		assert.False(t, found)
+		assert.Equal(t, 0, idx)
 	})
 
 	t.Run("Return zero and false on nil slice", func(t *testing.T) {
-		var slice []int = nil
-		idx, found := FindBy(slice, func(i int) bool {
-			return i == 0
-		})
-		assert.Equal(t, 0, idx)
+		idx, found := BinarySearchBy(nil, 1, cmpInts)
 		assert.False(t, found)
+		assert.Equal(t, 0, idx)
 	})
 }
 
-func TestFlatten(t *testing.T) {
-	t.Run("Flatten integer slice", func(t *testing.T) {
-		slice := [][]int{{1, 2, 3}, {4, 5, 6}, {7, 8}}
-		flat := Flatten(slice)
-		assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, flat)
-	})
+func TestBottomN(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
 
-	t.Run("Return nil on nil slice", func(t *testing.T) {
-		var slice [][]int = nil
-		flat := Flatten(slice)
-		assert.Nil(t, flat)
+	t.Run("Return smallest elements in ascending order", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2, 3}, BottomN([]int{5, 3, 8, 1, 9, 2}, 3, less))
 	})
-}
 
-func TestFold(t *testing.T) {
-	t.Run("Calculate sum and factorial", func(t *testing.T) {
-		numbers := []int{1, 2, 3, 4, 5, 6}
+	t.Run("Equal elements keep their order", func(t *testing.T) {
+		type item struct{ key, id int }
+		slice := []item{{2, 0}, {1, 1}, {2, 2}, {1, 3}, {2, 4}}
+		bottom := BottomN(slice, 3, func(a, b item) bool { return a.key < b.key })
+		assert.Equal(t, []item{{1, 1}, {1, 3}, {2, 0}}, bottom)
+	})
 
-		sum := Fold(numbers, 0, func(acc, next int) int {
-			return acc + next
-		})
-		assert.Equal(t, 0+1+2+3+4+5+6, sum)
+	t.Run("Return all elements sorted when n is larger than length", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2, 3}, BottomN([]int{3, 1, 2}, 5, less))
+	})
 
-		factorial := Fold(numbers, 1, func(acc, next int) int {
-			return acc * next
-		})
-		assert.Equal(t, 1*1*2*3*4*5*6, factorial)
+	t.Run("Return empty slice on non-positive n", func(t *testing.T) {
+		assert.Equal(t, []int{}, BottomN([]int{3, 1, 2}, 0, less))
 	})
 
-	t.Run("Return initial value on nil slice", func(t *testing.T) {
+	t.Run("Return nil on nil slice", func(t *testing.T) {
 		var slice []int = nil
+		assert.Nil(t, BottomN(slice, 2, less))
+	})
+}
 
-		folded := Fold(slice, 0, func(acc, next int) int {
-			return acc + next
-		})
-		assert.Equal(t, 0, folded)
+func TestChunkBy(t *testing.T) {
+	identity := func(i int) int { return i }
 
-		folded2 := Fold(slice, 42, func(acc, next int) int {
-			return acc + next
-		})
-		assert.Equal(t, 42, folded2)
+	t.Run("Start new chunk when key changes", func(t *testing.T) {
+		slice := []int{1, 1, 2, 2, 1}
+		assert.Equal(t, [][]int{{1, 1}, {2, 2}, {1}}, ChunkBy(slice, identity))
 	})
-}
 
-func TestFrequencies(t *testing.T) {
-	t.Run("Count integer frequencies", func(t *testing.T) {
-		slice := []int{1, 2, 3, 4, 0, 1, 4, 0, 0, 12, 3, 5, 7, 1}
-		frequencies := Frequencies(slice)
-		assert.Equal(t, map[int]int{
-			1:  3,
-			2:  1,
-			3:  2,
-			4:  2,
-			0:  3,
-			12: 1,
-			5:  1,
-			7:  1},
-			frequencies,
-		)
+	t.Run("Runs at start, middle and end", func(t *testing.T) {
+		slice := []int{3, 3, 3, 1, 4, 4, 1, 5, 5}
+		assert.Equal(t, [][]int{{3, 3, 3}, {1}, {4, 4}, {1}, {5, 5}}, ChunkBy(slice, identity))
 	})
 
-	t.Run("Empty map on empty slice", func(t *testing.T) {
-		slice := []int{}
-		frequencies := Frequencies(slice)
-		assert.Equal(t, map[int]int{}, frequencies)
+	t.Run("Chunk by derived key", func(t *testing.T) {
+		slice := []string{"apple", "avocado", "banana", "cherry", "cranberry"}
+		chunks := ChunkBy(slice, func(s string) byte { return s[0] })
+		assert.Equal(t, [][]string{{"apple", "avocado"}, {"banana"}, {"cherry", "cranberry"}}, chunks)
+	})
+
+	t.Run("Return empty slice on empty slice", func(t *testing.T) {
+		assert.Equal(t, [][]int{}, ChunkBy([]int{}, identity))
 	})
 
 	t.Run("Return nil on nil slice", func(t *testing.T) {
 		var slice []int = nil
-		frequencies := Frequencies(slice)
-		assert.Nil(t, frequencies)
+		assert.Nil(t, ChunkBy(slice, identity))
+	})
+
+	t.Run("Panic on nil key function", func(t *testing.T) {
+		assert.Panics(t, func() { ChunkBy[int, int]([]int{1}, nil) })
+	})
+}
+
+func TestChunkReduce(t *testing.T) {
+	sum := func(acc, val int) int { return acc + val }
+	notIncreasing := func(prev, cur int) bool { return cur <= prev }
+
+	t.Run("Sum runs of increasing values", func(t *testing.T) {
+		slice := []int{1, 2, 3, 2, 5, 1, 1}
+		sums := ChunkReduce(slice, 0, notIncreasing, sum)
+		assert.Equal(t, []int{6, 7, 1, 1}, sums)
+	})
+
+	t.Run("Reduce whole slice when never split", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		sums := ChunkReduce(slice, 10, func(prev, cur int) bool { return false }, sum)
+		assert.Equal(t, []int{16}, sums)
+	})
+
+	t.Run("Reduce each element when always split", func(t *testing.T) {
+		slice := []string{"a", "b", "c"}
+		joined := ChunkReduce(slice, ">", func(prev, cur string) bool { return true },
+			func(acc, val string) string { return acc + val })
+		assert.Equal(t, []string{">a", ">b", ">c"}, joined)
+	})
+
+	t.Run("Return empty slice on empty slice", func(t *testing.T) {
+		sums := ChunkReduce([]int{}, 0, notIncreasing, sum)
+		assert.Equal(t, []int{}, sums)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		sums := ChunkReduce(slice, 0, notIncreasing, sum)
+		assert.Nil(t, sums)
+	})
+}
+
+func TestClamp(t *testing.T) {
+	t.Run("Clamp values below, within and above range", func(t *testing.T) {
+		slice := []int{-5, 0, 3, 10, 11}
+		assert.Equal(t, []int{0, 0, 3, 10, 10}, Clamp(slice, 0, 10))
+		assert.Equal(t, []int{-5, 0, 3, 10, 11}, slice)
+	})
+
+	t.Run("Clamp to single value range", func(t *testing.T) {
+		assert.Equal(t, []float64{1, 1}, Clamp([]float64{-1, 2.5}, 1, 1))
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		assert.Nil(t, Clamp(slice, 0, 1))
+	})
+
+	t.Run("Panic when lower bound is greater than upper bound", func(t *testing.T) {
+		assert.PanicsWithValue(t, "sliceutils: Clamp lower bound is greater than upper bound", func() {
+			Clamp([]int{1}, 2, 1)
+		})
+	})
+}
+
+func TestClampInPlace(t *testing.T) {
+	t.Run("Clamp values below, within and above range", func(t *testing.T) {
+		slice := []int{-5, 0, 3, 10, 11}
+		ClampInPlace(slice, 0, 10)
+		assert.Equal(t, []int{0, 0, 3, 10, 10}, slice)
+	})
+
+	t.Run("Do nothing on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		ClampInPlace(slice, 0, 1)
+		assert.Nil(t, slice)
+	})
+
+	t.Run("Panic when lower bound is greater than upper bound", func(t *testing.T) {
+		assert.PanicsWithValue(t, "sliceutils: ClampInPlace lower bound is greater than upper bound", func() {
+			ClampInPlace([]int{1}, 2, 1)
+		})
+	})
+}
+
+func TestClampIndex(t *testing.T) {
+	slice := []int{1, 2, 3}
+
+	t.Run("Keep valid index", func(t *testing.T) {
+		assert.Equal(t, 1, ClampIndex(slice, 1))
+	})
+
+	t.Run("Clamp negative index to first", func(t *testing.T) {
+		assert.Equal(t, 0, ClampIndex(slice, -5))
+	})
+
+	t.Run("Clamp too large index to last", func(t *testing.T) {
+		assert.Equal(t, 2, ClampIndex(slice, 3))
+	})
+
+	t.Run("Return -1 on empty slice", func(t *testing.T) {
+		assert.Equal(t, -1, ClampIndex([]int{}, 0))
+	})
+
+	t.Run("Return -1 on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		assert.Equal(t, -1, ClampIndex(slice, 2))
+	})
+}
+
+func TestCollectResults(t *testing.T) {
+	t.Run("Separate values from errors", func(t *testing.T) {
+		errFoo := errors.New("foo")
+		errBar := errors.New("bar")
+		values := []int{1, 0, 3, 0}
+		errs := []error{nil, errFoo, nil, errBar}
+
+		okValues, outErrs := CollectResults(values, errs)
+		assert.Equal(t, []int{1, 3}, okValues)
+		assert.Equal(t, []error{errFoo, errBar}, outErrs)
+	})
+
+	t.Run("Return empty errors when all succeed", func(t *testing.T) {
+		okValues, outErrs := CollectResults([]string{"a", "b"}, []error{nil, nil})
+		assert.Equal(t, []string{"a", "b"}, okValues)
+		assert.Equal(t, []error{}, outErrs)
+	})
+
+	t.Run("Return nil slices on nil slices", func(t *testing.T) {
+		okValues, outErrs := CollectResults[int](nil, nil)
+		assert.Nil(t, okValues)
+		assert.Nil(t, outErrs)
+	})
+
+	t.Run("Panic on slices of different length", func(t *testing.T) {
+		assert.Panics(t, func() { CollectResults([]int{1, 2}, []error{nil}) })
+	})
+}
+
+func TestCompactFunc(t *testing.T) {
+	eq := func(a, b int) bool { return a == b }
+
+	t.Run("Collapse only adjacent duplicates", func(t *testing.T) {
+		slice := []int{1, 1, 2, 1}
+		compacted := CompactFunc(slice, eq)
+		assert.Equal(t, []int{1, 2, 1}, compacted)
+	})
+
+	t.Run("Collapse leading, interior and trailing runs", func(t *testing.T) {
+		slice := []int{1, 1, 1, 2, 3, 3, 4, 5, 5}
+		compacted := CompactFunc(slice, eq)
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, compacted)
+	})
+
+	t.Run("Keep first element of each run", func(t *testing.T) {
+		slice := []string{"Foo", "foo", "FOO", "bar"}
+		compacted := CompactFunc(slice, strings.EqualFold)
+		assert.Equal(t, []string{"Foo", "bar"}, compacted)
+	})
+
+	t.Run("Slice without adjacent duplicates", func(t *testing.T) {
+		slice := []int{1, 2, 3, 1}
+		compacted := CompactFunc(slice, eq)
+		assert.Equal(t, []int{1, 2, 3, 1}, compacted)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		compacted := CompactFunc(slice, eq)
+		assert.Nil(t, compacted)
+	})
+}
+
+func TestContains(t *testing.T) {
+	t.Run("Slice contains element", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4}
+		contains := Contains(slice, 2)
+		assert.True(t, contains)
+	})
+
+	t.Run("Slice does not contain element", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4}
+		contains := Contains(slice, 5)
+		assert.False(t, contains)
+	})
+
+	t.Run("Return false on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		contains := Contains(slice, 1)
+		assert.False(t, contains)
+	})
+}
+
+func TestContainsAll(t *testing.T) {
+	t.Run("Slice contains all values", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4}
+		assert.True(t, ContainsAll(slice, 4, 2, 2))
+	})
+
+	t.Run("Slice contains some values", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4}
+		assert.False(t, ContainsAll(slice, 2, 5))
+	})
+
+	t.Run("Return true on no values", func(t *testing.T) {
+		assert.True(t, ContainsAll([]int{1}))
+		assert.True(t, ContainsAll[int](nil))
+	})
+
+	t.Run("Return false on nil slice with values", func(t *testing.T) {
+		assert.False(t, ContainsAll(nil, 1))
+	})
+}
+
+func TestContainsAny(t *testing.T) {
+	t.Run("Slice contains some values", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4}
+		assert.True(t, ContainsAny(slice, 5, 3))
+	})
+
+	t.Run("Slice contains all values", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4}
+		assert.True(t, ContainsAny(slice, 1, 2))
+	})
+
+	t.Run("Slice contains none of values", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4}
+		assert.False(t, ContainsAny(slice, 5, 6))
+	})
+
+	t.Run("Return false on no values", func(t *testing.T) {
+		assert.False(t, ContainsAny([]int{1}))
+	})
+
+	t.Run("Return false on nil slice", func(t *testing.T) {
+		assert.False(t, ContainsAny(nil, 1))
+	})
+}
+
+func TestContainsBy(t *testing.T) {
+	t.Run("Slice contains matching element", func(t *testing.T) {
+		slice := [][]int{{1}, {2, 3}}
+		contains := ContainsBy(slice, func(s []int) bool { return len(s) == 2 })
+		assert.True(t, contains)
+	})
+
+	t.Run("Slice does not contain matching element", func(t *testing.T) {
+		slice := [][]int{{1}, {2, 3}}
+		contains := ContainsBy(slice, func(s []int) bool { return len(s) == 0 })
+		assert.False(t, contains)
+	})
+
+	t.Run("Return false on nil slice", func(t *testing.T) {
+		var slice [][]int = nil
+		contains := ContainsBy(slice, func(s []int) bool { return true })
+		assert.False(t, contains)
+	})
+}
+
+func TestCount(t *testing.T) {
+	t.Run("Count zeros", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4, 0, 1, 4, 0, 0, 12, 3, 5, 7, 1}
+		count := Count(slice, func(i int) bool { return i == 0 })
+		assert.Equal(t, 3, count)
+	})
+
+	t.Run("Return zero on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		count := Count(slice, func(i int) bool { return i == 0 })
+		assert.Equal(t, 0, count)
+	})
+}
+
+func TestCountBy(t *testing.T) {
+	t.Run("Count integers by parity", func(t *testing.T) {
+		counts := CountBy([]int{1, 2, 3, 4, 5}, func(i int) bool { return i%2 == 0 })
+		assert.Equal(t, map[bool]int{true: 2, false: 3}, counts)
+	})
+
+	t.Run("Count words by length", func(t *testing.T) {
+		counts := CountBy([]string{"a", "bb", "cc", "d", "eee"}, func(s string) int { return len(s) })
+		assert.Equal(t, map[int]int{1: 2, 2: 2, 3: 1}, counts)
+	})
+
+	t.Run("Return empty map on empty slice", func(t *testing.T) {
+		counts := CountBy([]int{}, func(i int) int { return i })
+		assert.Equal(t, map[int]int{}, counts)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		assert.Nil(t, CountBy(slice, func(i int) int { return i }))
+	})
+
+	t.Run("Panic on nil key function", func(t *testing.T) {
+		assert.Panics(t, func() { CountBy[int, int]([]int{1}, nil) })
+	})
+}
+
+func TestCumulativeMax(t *testing.T) {
+	t.Run("Return running maxima", func(t *testing.T) {
+		assert.Equal(t, []int{3, 3, 4, 4, 5}, CumulativeMax([]int{3, 1, 4, 1, 5}))
+	})
+
+	t.Run("Return running maxima of strings", func(t *testing.T) {
+		assert.Equal(t, []string{"b", "b", "c"}, CumulativeMax([]string{"b", "a", "c"}))
+	})
+
+	t.Run("Order NaN before other values", func(t *testing.T) {
+		nan := math.NaN()
+		maxima := CumulativeMax([]float64{nan, 1, nan, 3, 2})
+		assert.True(t, math.IsNaN(maxima[0]))
+		assert.Equal(t, []float64{1, 1, 3, 3}, maxima[1:])
+	})
+
+	t.Run("Return empty slice on empty slice", func(t *testing.T) {
+		assert.Equal(t, []int{}, CumulativeMax([]int{}))
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		assert.Nil(t, CumulativeMax(slice))
+	})
+}
+
+func TestCumulativeSum(t *testing.T) {
+	t.Run("Return running totals", func(t *testing.T) {
+		assert.Equal(t, []int{1, 3, 6}, CumulativeSum([]int{1, 2, 3}))
+	})
+
+	t.Run("Return running totals of floats", func(t *testing.T) {
+		assert.Equal(t, []float64{0.5, 0.75, -0.25}, CumulativeSum([]float64{0.5, 0.25, -1}))
+	})
+
+	t.Run("Return empty slice on empty slice", func(t *testing.T) {
+		assert.Equal(t, []int{}, CumulativeSum([]int{}))
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		assert.Nil(t, CumulativeSum(slice))
+	})
+}
+
+func TestDeduplicate(t *testing.T) {
+	t.Run("Slice with duplicates", func(t *testing.T) {
+		slice := []int{1, 2, 3, 2}
+		depupped := Deduplicate(slice)
+		assert.Equal(t, []int{1, 2, 3}, depupped)
+	})
+
+	t.Run("Slice without duplicates", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		depupped := Deduplicate(slice)
+		assert.Equal(t, []int{1, 2, 3}, depupped)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		depupped := Deduplicate(slice)
+		assert.Nil(t, depupped)
+	})
+}
+
+func TestDeduplicateBy(t *testing.T) {
+	type record struct {
+		id   int
+		tags []string
+	}
+	id := func(r record) int { return r.id }
+
+	t.Run("Deduplicate structs by ID", func(t *testing.T) {
+		slice := []record{{1, []string{"a"}}, {2, nil}, {1, []string{"b"}}, {3, nil}, {2, []string{"c"}}}
+		depupped := DeduplicateBy(slice, id)
+		assert.Equal(t, []record{{1, []string{"a"}}, {2, nil}, {3, nil}}, depupped)
+	})
+
+	t.Run("Slice without duplicate keys", func(t *testing.T) {
+		slice := []record{{1, nil}, {2, nil}}
+		depupped := DeduplicateBy(slice, id)
+		assert.Equal(t, []record{{1, nil}, {2, nil}}, depupped)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []record = nil
+		depupped := DeduplicateBy(slice, id)
+		assert.Nil(t, depupped)
+	})
+}
+
+func TestDeduplicateByHash(t *testing.T) {
+	hashInts := func(slice []int) uint64 {
+		h := fnv.New64a()
+		for _, val := range slice {
+			h.Write([]byte(strconv.Itoa(val) + ","))
+		}
+		return h.Sum64()
+	}
+
+	t.Run("Non-comparable slice with duplicates", func(t *testing.T) {
+		slice := [][]int{{1, 2}, {3}, {1, 2}, {}, {3}}
+		depupped := DeduplicateByHash(slice, hashInts)
+		assert.Equal(t, [][]int{{1, 2}, {3}, {}}, depupped)
+	})
+
+	t.Run("Keep first element on equal hashes", func(t *testing.T) {
+		slice := []string{"foo", "bar", "bazz"}
+		depupped := DeduplicateByHash(slice, func(s string) uint64 { return uint64(len(s)) })
+		assert.Equal(t, []string{"foo", "bazz"}, depupped)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice [][]int = nil
+		depupped := DeduplicateByHash(slice, hashInts)
+		assert.Nil(t, depupped)
+	})
+}
+
+func TestDeduplicateByResolve(t *testing.T) {
+	type pkg struct {
+		name    string
+		version int
+	}
+	name := func(p pkg) string { return p.name }
+	newest := func(existing, candidate pkg) pkg {
+		if candidate.version > existing.version {
+			return candidate
+		}
+		return existing
+	}
+
+	t.Run("Keep highest version per name", func(t *testing.T) {
+		slice := []pkg{{"foo", 1}, {"bar", 2}, {"foo", 3}, {"bar", 1}, {"baz", 1}}
+		depupped := DeduplicateByResolve(slice, name, newest)
+		assert.Equal(t, []pkg{{"foo", 3}, {"bar", 2}, {"baz", 1}}, depupped)
+	})
+
+	t.Run("Slice without duplicate keys", func(t *testing.T) {
+		slice := []pkg{{"foo", 1}, {"bar", 2}}
+		depupped := DeduplicateByResolve(slice, name, newest)
+		assert.Equal(t, []pkg{{"foo", 1}, {"bar", 2}}, depupped)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []pkg = nil
+		depupped := DeduplicateByResolve(slice, name, newest)
+		assert.Nil(t, depupped)
+	})
+}
+
+func TestDeduplicateChanged(t *testing.T) {
+	t.Run("Slice with duplicates", func(t *testing.T) {
+		slice := []int{1, 2, 3, 2}
+		depupped, changed := DeduplicateChanged(slice)
+		assert.Equal(t, []int{1, 2, 3}, depupped)
+		assert.True(t, changed)
+	})
+
+	t.Run("Slice without duplicates", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		depupped, changed := DeduplicateChanged(slice)
+		assert.Equal(t, []int{1, 2, 3}, depupped)
+		assert.False(t, changed)
+	})
+
+	t.Run("Return nil and false on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		depupped, changed := DeduplicateChanged(slice)
+		assert.Nil(t, depupped)
+		assert.False(t, changed)
+	})
+}
+
+func TestDeduplicateInPlace(t *testing.T) {
+	t.Run("Slice with duplicates", func(t *testing.T) {
+		slice := []int{1, 2, 3, 2}
+		DeduplicateInPlace(&slice)
+		assert.Equal(t, []int{1, 2, 3}, slice)
+	})
+
+	t.Run("Slice without duplicates", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		DeduplicateInPlace(&slice)
+		assert.Equal(t, []int{1, 2, 3}, slice)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		DeduplicateInPlace(&slice)
+		assert.Nil(t, slice)
+	})
+
+	t.Run("Do nothing on nil slice pointer", func(t *testing.T) {
+		DeduplicateInPlace[int](nil)
+	})
+}
+
+func TestDeduplicateInPlaceSorted(t *testing.T) {
+	t.Run("Sorted slice with duplicates", func(t *testing.T) {
+		slice := []int{1, 1, 2, 3, 3, 3, 4}
+		DeduplicateInPlaceSorted(&slice)
+		assert.Equal(t, []int{1, 2, 3, 4}, slice)
+	})
+
+	t.Run("Sorted slice without duplicates", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		DeduplicateInPlaceSorted(&slice)
+		assert.Equal(t, []int{1, 2, 3}, slice)
+	})
+
+	t.Run("Slice with only duplicates", func(t *testing.T) {
+		slice := []string{"a", "a", "a"}
+		DeduplicateInPlaceSorted(&slice)
+		assert.Equal(t, []string{"a"}, slice)
+	})
+
+	t.Run("Does not allocate", func(t *testing.T) {
+		slice := []int{1, 1, 2, 3, 3}
+		allocs := testing.AllocsPerRun(10, func() {
+			s := slice
+			DeduplicateInPlaceSorted(&s)
+		})
+		assert.Equal(t, 0.0, allocs)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		DeduplicateInPlaceSorted(&slice)
+		assert.Nil(t, slice)
+	})
+
+	t.Run("Do nothing on nil slice pointer", func(t *testing.T) {
+		DeduplicateInPlaceSorted[int](nil)
+	})
+}
+
+func TestDeduplicateSortedOutput(t *testing.T) {
+	t.Run("Slice with duplicates", func(t *testing.T) {
+		slice := []int{3, 1, 2, 3, 1}
+		depupped := DeduplicateSortedOutput(slice)
+		assert.Equal(t, []int{1, 2, 3}, depupped)
+		assert.Equal(t, []int{3, 1, 2, 3, 1}, slice)
+	})
+
+	t.Run("Strings without duplicates", func(t *testing.T) {
+		slice := []string{"foo", "bar", "baz"}
+		depupped := DeduplicateSortedOutput(slice)
+		assert.Equal(t, []string{"bar", "baz", "foo"}, depupped)
+	})
+
+	t.Run("Collapse NaNs and order them first", func(t *testing.T) {
+		nan := math.NaN()
+		slice := []float64{2, nan, 1, nan, 2, math.Inf(-1), nan}
+		depupped := DeduplicateSortedOutput(slice)
+		assert.Len(t, depupped, 4)
+		assert.True(t, math.IsNaN(depupped[0]))
+		assert.Equal(t, []float64{math.Inf(-1), 1, 2}, depupped[1:])
+	})
+
+	t.Run("Return empty slice on empty slice", func(t *testing.T) {
+		depupped := DeduplicateSortedOutput([]int{})
+		assert.Equal(t, []int{}, depupped)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		depupped := DeduplicateSortedOutput(slice)
+		assert.Nil(t, depupped)
+	})
+}
+
+func TestDiffSummary(t *testing.T) {
+	t.Run("Summarize overlapping slices", func(t *testing.T) {
+		old := []string{"a", "b", "c", "b"}
+		new := []string{"d", "c", "a", "d"}
+		added, removed, common := DiffSummary(old, new)
+		assert.Equal(t, []string{"d"}, added)
+		assert.Equal(t, []string{"b"}, removed)
+		assert.Equal(t, []string{"a", "c"}, common)
+	})
+
+	t.Run("Match Difference and Intersection", func(t *testing.T) {
+		old := []int{1, 2, 3, 4}
+		new := []int{3, 4, 5}
+		added, removed, common := DiffSummary(old, new)
+		assert.Equal(t, Difference(new, old), added)
+		assert.Equal(t, Difference(old, new), removed)
+		assert.Equal(t, Intersection(old, new), common)
+	})
+
+	t.Run("Everything is added from nil", func(t *testing.T) {
+		added, removed, common := DiffSummary(nil, []int{1, 2})
+		assert.Equal(t, []int{1, 2}, added)
+		assert.Equal(t, []int{}, removed)
+		assert.Equal(t, []int{}, common)
+	})
+
+	t.Run("Return nil slices when both slices are nil", func(t *testing.T) {
+		added, removed, common := DiffSummary[int](nil, nil)
+		assert.Nil(t, added)
+		assert.Nil(t, removed)
+		assert.Nil(t, common)
+	})
+
+	t.Run("Deduplicate all results", func(t *testing.T) {
+		old := []int{1, 2, 1, 3, 2, 1}
+		new := []int{4, 2, 4, 2, 5, 3}
+		added, removed, common := DiffSummary(old, new)
+		assert.Equal(t, []int{4, 5}, added)
+		assert.Equal(t, []int{1}, removed)
+		assert.Equal(t, []int{2, 3}, common)
+	})
+}
+
+func TestDifference(t *testing.T) {
+	t.Run("Difference of two overlapping sets", func(t *testing.T) {
+		a := []int{1, 2, 3}
+		b := []int{3, 2, 6}
+		difference := Difference(a, b)
+		assert.Equal(t, []int{1}, difference)
+	})
+
+	t.Run("Difference of two non-overlapping sets", func(t *testing.T) {
+		a := []int{1, 2, 3}
+		b := []int{5, 4, 6}
+		difference := Difference(a, b)
+		assert.Equal(t, []int{1, 2, 3}, difference)
+	})
+
+	t.Run("Return nil when both sets are nil", func(t *testing.T) {
+		difference := Difference[int](nil, nil)
+		assert.Nil(t, difference)
+	})
+}
+
+func TestDifferenceBy(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	id := func(u user) int { return u.id }
+
+	t.Run("Difference of structs by ID", func(t *testing.T) {
+		a := []user{{1, "a"}, {2, "b"}, {3, "c"}}
+		b := []user{{2, "x"}, {4, "y"}}
+		assert.Equal(t, []user{{1, "a"}, {3, "c"}}, DifferenceBy(a, b, id))
+	})
+
+	t.Run("Difference of non-overlapping sets", func(t *testing.T) {
+		a := []user{{1, "a"}}
+		b := []user{{2, "b"}}
+		assert.Equal(t, []user{{1, "a"}}, DifferenceBy(a, b, id))
+	})
+
+	t.Run("Return nil when both sets are nil", func(t *testing.T) {
+		assert.Nil(t, DifferenceBy(nil, nil, id))
+	})
+}
+
+func TestDifferenceN(t *testing.T) {
+	t.Run("Difference of three overlapping sets", func(t *testing.T) {
+		a := []int{1, 2, 3, 4, 5}
+		b := []int{2, 6}
+		c := []int{5, 4, 7}
+		assert.Equal(t, []int{1, 3}, DifferenceN(a, b, c))
+	})
+
+	t.Run("Keep order of the first set", func(t *testing.T) {
+		a := []int{5, 3, 1, 4}
+		b := []int{4}
+		assert.Equal(t, []int{5, 3, 1}, DifferenceN(a, b))
+	})
+
+	t.Run("Return copy of the first set on no other sets", func(t *testing.T) {
+		a := []int{1, 2, 3}
+		assert.Equal(t, []int{1, 2, 3}, DifferenceN(a))
+	})
+
+	t.Run("Return nil when the first set is nil", func(t *testing.T) {
+		assert.Nil(t, DifferenceN(nil, []int{1, 2}))
+	})
+}
+
+func TestDotProduct(t *testing.T) {
+	t.Run("Dot product of slices of equal lengths", func(t *testing.T) {
+		product, ok := DotProduct([]int{1, 2, 3}, []int{4, -5, 6})
+		assert.True(t, ok)
+		assert.Equal(t, 12, product)
+	})
+
+	t.Run("Return false on mismatched lengths", func(t *testing.T) {
+		product, ok := DotProduct([]float64{1, 2}, []float64{1})
+		assert.False(t, ok)
+		assert.Equal(t, 0.0, product)
+	})
+
+	t.Run("Return zero on empty slices", func(t *testing.T) {
+		product, ok := DotProduct([]int{}, nil)
+		assert.True(t, ok)
+		assert.Equal(t, 0, product)
+	})
+
+	t.Run("Do not allocate", func(t *testing.T) {
+		lhs := []int{1, 2, 3}
+		rhs := []int{4, 5, 6}
+		allocs := testing.AllocsPerRun(10, func() { DotProduct(lhs, rhs) })
+		assert.Equal(t, 0.0, allocs)
+	})
+}
+
+func TestDrop(t *testing.T) {
+	t.Run("Drop first elements", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4}
+		assert.Equal(t, []int{3, 4}, Drop(slice, 2))
+	})
+
+	t.Run("Drop nothing with zero", func(t *testing.T) {
+		slice := []int{1, 2}
+		assert.Equal(t, []int{1, 2}, Drop(slice, 0))
+	})
+
+	t.Run("Drop nothing with negative n", func(t *testing.T) {
+		slice := []int{1, 2}
+		assert.Equal(t, []int{1, 2}, Drop(slice, -1))
+	})
+
+	t.Run("Return empty slice when n is larger than length", func(t *testing.T) {
+		slice := []int{1, 2}
+		assert.Equal(t, []int{}, Drop(slice, 3))
+	})
+
+	t.Run("Result shares backing array", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		Drop(slice, 1)[0] = 9
+		assert.Equal(t, []int{1, 9, 3}, slice)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		assert.Nil(t, Drop(slice, 1))
+	})
+}
+
+func TestDropWhile(t *testing.T) {
+	isPositive := func(i int) bool { return i > 0 }
+
+	t.Run("Predicate false in the middle", func(t *testing.T) {
+		slice := []int{1, 2, -3, 4, -5}
+		rest := DropWhile(slice, isPositive)
+		assert.Equal(t, []int{-3, 4, -5}, rest)
+	})
+
+	t.Run("Predicate immediately false", func(t *testing.T) {
+		slice := []int{-1, 2}
+		rest := DropWhile(slice, isPositive)
+		assert.Equal(t, []int{-1, 2}, rest)
+	})
+
+	t.Run("Predicate always true", func(t *testing.T) {
+		slice := []int{1, 2}
+		rest := DropWhile(slice, isPositive)
+		assert.Equal(t, []int{}, rest)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		rest := DropWhile(slice, isPositive)
+		assert.Nil(t, rest)
+	})
+}
+
+func TestEqual(t *testing.T) {
+	t.Run("Equal slices", func(t *testing.T) {
+		assert.True(t, Equal([]int{1, 2, 3}, []int{1, 2, 3}))
+	})
+
+	t.Run("Slices with different elements", func(t *testing.T) {
+		assert.False(t, Equal([]int{1, 2, 3}, []int{1, 5, 3}))
+	})
+
+	t.Run("Slices with same elements in different order", func(t *testing.T) {
+		assert.False(t, Equal([]int{1, 2}, []int{2, 1}))
+	})
+
+	t.Run("Slices with different lengths", func(t *testing.T) {
+		assert.False(t, Equal([]int{1, 2}, []int{1, 2, 3}))
+	})
+
+	t.Run("Nil and empty slices are equal", func(t *testing.T) {
+		assert.True(t, Equal[int](nil, nil))
+		assert.True(t, Equal(nil, []int{}))
+		assert.True(t, Equal([]int{}, nil))
+	})
+}
+
+func TestEqualBy(t *testing.T) {
+	equalFold := strings.EqualFold
+
+	t.Run("Equal slices with custom equality", func(t *testing.T) {
+		assert.True(t, EqualBy([]string{"Foo", "BAR"}, []string{"foo", "bar"}, equalFold))
+	})
+
+	t.Run("Slices with different elements", func(t *testing.T) {
+		assert.False(t, EqualBy([]string{"foo", "bar"}, []string{"foo", "baz"}, equalFold))
+	})
+
+	t.Run("Non-comparable elements", func(t *testing.T) {
+		a := [][]int{{1}, {2, 3}}
+		b := [][]int{{1}, {2, 3}}
+		assert.True(t, EqualBy(a, b, Equal[int]))
+	})
+
+	t.Run("Slices with different lengths", func(t *testing.T) {
+		assert.False(t, EqualBy([]string{"foo"}, []string{"foo", "bar"}, equalFold))
+	})
+
+	t.Run("Nil and empty slices are equal", func(t *testing.T) {
+		assert.True(t, EqualBy(nil, nil, equalFold))
+		assert.True(t, EqualBy(nil, []string{}, equalFold))
+	})
+}
+
+func TestEqualSorted(t *testing.T) {
+	t.Run("Equal sorted slices", func(t *testing.T) {
+		a := []int{1, 2, 2, 5}
+		b := []int{1, 2, 2, 5}
+		assert.True(t, EqualSorted(a, b))
+	})
+
+	t.Run("Sorted slices with different multiplicities", func(t *testing.T) {
+		a := []int{1, 2, 2, 5}
+		b := []int{1, 2, 5, 5}
+		assert.False(t, EqualSorted(a, b))
+	})
+
+	t.Run("Slices with different lengths", func(t *testing.T) {
+		a := []int{1, 2}
+		b := []int{1, 2, 3}
+		assert.False(t, EqualSorted(a, b))
+	})
+
+	t.Run("Nil and empty slices are equal", func(t *testing.T) {
+		assert.True(t, EqualSorted[int](nil, nil))
+		assert.True(t, EqualSorted(nil, []int{}))
+	})
+}
+
+func TestEqualUnorderedBy(t *testing.T) {
+	identity := func(i int) int { return i }
+
+	t.Run("Equal multisets in different order", func(t *testing.T) {
+		a := []int{1, 2, 2, 3}
+		b := []int{2, 3, 1, 2}
+		assert.True(t, EqualUnorderedBy(a, b, identity))
+	})
+
+	t.Run("Different multiplicities", func(t *testing.T) {
+		a := []int{1, 2, 2, 3}
+		b := []int{1, 2, 3, 3}
+		assert.False(t, EqualUnorderedBy(a, b, identity))
+	})
+
+	t.Run("Case-insensitive anagram", func(t *testing.T) {
+		a := strings.Split("Listen", "")
+		b := strings.Split("siLent", "")
+		assert.True(t, EqualUnorderedBy(a, b, strings.ToLower))
+	})
+
+	t.Run("Non-comparable elements by key", func(t *testing.T) {
+		a := [][]int{{1}, {2, 3}}
+		b := [][]int{{2, 3}, {1}}
+		assert.True(t, EqualUnorderedBy(a, b, func(s []int) int { return Fold(s, 0, func(acc, v int) int { return acc*10 + v }) }))
+	})
+
+	t.Run("Slices with different lengths", func(t *testing.T) {
+		assert.False(t, EqualUnorderedBy([]int{1}, []int{1, 1}, identity))
+	})
+
+	t.Run("Nil and empty slices are equal", func(t *testing.T) {
+		assert.True(t, EqualUnorderedBy(nil, nil, identity))
+		assert.True(t, EqualUnorderedBy(nil, []int{}, identity))
+	})
+}
+
+func TestFill(t *testing.T) {
+	t.Run("Fill slice of positive length", func(t *testing.T) {
+		assert.Equal(t, []string{"a", "a", "a"}, Fill(3, "a"))
+	})
+
+	t.Run("Return empty slice on zero length", func(t *testing.T) {
+		assert.Equal(t, []int{}, Fill(0, 1))
+	})
+
+	t.Run("Return empty slice on negative length", func(t *testing.T) {
+		assert.Equal(t, []int{}, Fill(-2, 1))
+	})
+}
+
+func TestFillInPlace(t *testing.T) {
+	t.Run("Overwrite every element", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		FillInPlace(slice, 7)
+		assert.Equal(t, []int{7, 7, 7}, slice)
+	})
+
+	t.Run("Do not write beyond length", func(t *testing.T) {
+		slice := make([]int, 2, 4)
+		FillInPlace(slice, 7)
+		assert.Equal(t, []int{7, 7, 0, 0}, slice[:4])
+	})
+
+	t.Run("Do nothing on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		FillInPlace(slice, 7)
+		assert.Nil(t, slice)
+	})
+}
+
+func TestFilter(t *testing.T) {
+	t.Run("Retain strings shorter than 4 characters", func(t *testing.T) {
+		slice := []string{"hello", "foo", "bar", "pointer", "cow", "F"}
+		filtered := Filter(slice, func(s string) bool { return len(s) < 4 })
+		assert.Equal(t, []string{"foo", "bar", "cow", "F"}, filtered)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		flat := Filter(slice, func(i int) bool { return i < 4 })
+		assert.Nil(t, flat)
+	})
+}
+
+func TestFilterInPlace(t *testing.T) {
+	t.Run("Retain strings shorter than 4 characters", func(t *testing.T) {
+		slice := []string{"hello", "foo", "bar", "pointer", "cow", "F"}
+		FilterInPlace(&slice, func(s string) bool { return len(s) < 4 })
+		assert.Equal(t, []string{"foo", "bar", "cow", "F"}, slice)
+	})
+
+	t.Run("Do nothing on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		FilterInPlace(&slice, func(i int) bool { return i < 4 })
+		assert.Nil(t, slice)
+	})
+
+	t.Run("Do nothing on nil slice pointer", func(t *testing.T) {
+		FilterInPlace(nil, func(i int) bool { return i < 4 })
+	})
+}
+
+func TestFilterIndexed(t *testing.T) {
+	t.Run("Retain elements at even indices", func(t *testing.T) {
+		slice := []string{"a", "b", "c", "d", "e"}
+		filtered := FilterIndexed(slice, func(idx int, s string) bool { return idx%2 == 0 })
+		assert.Equal(t, []string{"a", "c", "e"}, filtered)
+	})
+
+	t.Run("Retain elements by value", func(t *testing.T) {
+		slice := []int{5, 1, 7, 2}
+		filtered := FilterIndexed(slice, func(idx int, val int) bool { return val > 4 })
+		assert.Equal(t, []int{5, 7}, filtered)
+	})
+
+	t.Run("Retain elements equal to their index", func(t *testing.T) {
+		slice := []int{0, 2, 2, 1, 4}
+		filtered := FilterIndexed(slice, func(idx int, val int) bool { return idx == val })
+		assert.Equal(t, []int{0, 2, 4}, filtered)
+	})
+
+	t.Run("Return empty slice on empty slice", func(t *testing.T) {
+		filtered := FilterIndexed([]int{}, func(idx int, val int) bool { return true })
+		assert.Equal(t, []int{}, filtered)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		filtered := FilterIndexed(slice, func(idx int, val int) bool { return true })
+		assert.Nil(t, filtered)
+	})
+}
+
+func TestFilterMap(t *testing.T) {
+	ToPointer := func(s string) *string {
+		return &s
+	}
+
+	t.Run("Pointers to concrete types", func(t *testing.T) {
+		slice := []*string{
+			ToPointer("hello"),
+			nil,
+			ToPointer("foo"),
+			nil,
+			nil,
+			ToPointer("bar"),
+		}
+		filterMapped := FilterMap(slice, func(s *string) (string, bool) {
+			if s == nil {
+				return "", false
+			}
+			return *s, true
+		})
+		assert.Equal(t, []string{"hello", "foo", "bar"}, filterMapped)
+	})
+
+	t.Run("Strings to their byte lengths if under 4", func(t *testing.T) {
+		slice := []string{"hello", "foo", "bar", "pointer", "cow", "F"}
+		filterMapped := FilterMap(slice, func(s string) (int, bool) {
+			slen := len(s)
+			return slen, slen < 4
+		})
+		assert.Equal(t, []int{3, 3, 3, 1}, filterMapped)
+	})
+
+	t.Run("Parse ints and discard failing strings", func(t *testing.T) {
+		slice := []string{"1", "2", "-3", "foo", "4", "n"}
+		filterMapped := FilterMap(slice, func(s string) (int, bool) {
+			value, err := strconv.Atoi(s)
+			return value, err == nil
+		})
+		assert.Equal(t, []int{1, 2, -3, 4}, filterMapped)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		filterMapped := FilterMap(slice, func(i int) (int, bool) { return i, i > 0 })
+		assert.Nil(t, filterMapped)
+	})
+}
+
+func TestFilterMapIndex(t *testing.T) {
+	t.Run("Format every other string with its index", func(t *testing.T) {
+		slice := []string{"foo", "bar", "baz", "qux", "quux"}
+		formatted := FilterMapIndex(slice, func(idx int, s string) (string, bool) {
+			return strconv.Itoa(idx) + ": " + s, idx%2 == 0
+		})
+		assert.Equal(t, []string{"0: foo", "2: baz", "4: quux"}, formatted)
+	})
+
+	t.Run("Return empty slice when nothing is kept", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		filtered := FilterMapIndex(slice, func(idx int, val int) (int, bool) { return val, false })
+		assert.Equal(t, []int{}, filtered)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		filtered := FilterMapIndex(slice, func(idx int, val int) (int, bool) { return val, true })
+		assert.Nil(t, filtered)
+	})
+}
+
+func TestFindBy(t *testing.T) {
+	t.Run("Try to find and is found", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4, 5, 6, 7, 8}
+		idx, found := FindBy(slice, func(i int) bool {
+			return i == 6
+		})
+		assert.Equal(t, 5, idx)
+		assert.True(t, found)
+	})
+
+	t.Run("Try to find and is not found", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4, 5, 6, 7, 8}
+		idx, found := FindBy(slice, func(i int) bool {
+			return i == 9
+		})
+		assert.Equal(t, 0, idx)
+		assert.False(t, found)
+	})
+
+	t.Run("Return zero and false on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		idx, found := FindBy(slice, func(i int) bool {
+			return i == 0
+		})
+		assert.Equal(t, 0, idx)
+		assert.False(t, found)
+	})
+}
+
+func TestFindIndices(t *testing.T) {
+	isEven := func(i int) bool { return i%2 == 0 }
+
+	t.Run("Return indices of multiple matches", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2, 5}, FindIndices([]int{1, 2, 4, 5, 7, 8}, isEven))
+	})
+
+	t.Run("Return empty slice on no matches", func(t *testing.T) {
+		assert.Equal(t, []int{}, FindIndices([]int{1, 3, 5}, isEven))
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		assert.Nil(t, FindIndices(slice, isEven))
+	})
+
+	t.Run("Panic on nil predicate function", func(t *testing.T) {
+		assert.Panics(t, func() { FindIndices([]int{1}, nil) })
+	})
+}
+
+func TestFindLastBy(t *testing.T) {
+	t.Run("Find the last of several matches", func(t *testing.T) {
+		slice := []string{"error: foo", "info: bar", "error: baz", "info: qux"}
+		idx, found := FindLastBy(slice, func(s string) bool { return strings.HasPrefix(s, "error") })
+		assert.Equal(t, 2, idx)
+		assert.True(t, found)
+	})
+
+	t.Run("Try to find and is not found", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		idx, found := FindLastBy(slice, func(i int) bool { return i > 3 })
+		assert.Equal(t, 0, idx)
+		assert.False(t, found)
+	})
+
+	t.Run("Return zero and false on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		idx, found := FindLastBy(slice, func(i int) bool { return true })
+		assert.Equal(t, 0, idx)
+		assert.False(t, found)
+	})
+}
+
+func TestFindMap(t *testing.T) {
+	parsePositive := func(s string) (int, bool) {
+		i, err := strconv.Atoi(s)
+		return i, err == nil && i > 0
+	}
+
+	t.Run("Find early match", func(t *testing.T) {
+		slice := []string{"12", "foo", "3"}
+		found, ok := FindMap(slice, parsePositive)
+		assert.Equal(t, 12, found)
+		assert.True(t, ok)
+	})
+
+	t.Run("Find late match", func(t *testing.T) {
+		slice := []string{"foo", "-1", "0", "7"}
+		found, ok := FindMap(slice, parsePositive)
+		assert.Equal(t, 7, found)
+		assert.True(t, ok)
+	})
+
+	t.Run("Return zero value and false on no match", func(t *testing.T) {
+		slice := []string{"foo", "-1"}
+		found, ok := FindMap(slice, parsePositive)
+		assert.Equal(t, 0, found)
+		assert.False(t, ok)
+	})
+
+	t.Run("Return zero value and false on nil slice", func(t *testing.T) {
+		var slice []string = nil
+		found, ok := FindMap(slice, parsePositive)
+		assert.Equal(t, 0, found)
+		assert.False(t, ok)
+	})
+}
+
+func TestFlatMap(t *testing.T) {
+	t.Run("Map strings to their fields", func(t *testing.T) {
+		slice := []string{"foo bar", "", "baz"}
+		words := FlatMap(slice, strings.Fields)
+		assert.Equal(t, []string{"foo", "bar", "baz"}, words)
+	})
+
+	t.Run("Skip nil sub-slices", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		repeated := FlatMap(slice, func(i int) []int {
+			if i == 2 {
+				return nil
+			}
+			return []int{i, i}
+		})
+		assert.Equal(t, []int{1, 1, 3, 3}, repeated)
+	})
+
+	t.Run("Match Flatten and Map", func(t *testing.T) {
+		slice := []int{3, 0, 2}
+		mapFn := func(n int) []int { return Generate(n, func(idx int) int { return idx }) }
+		assert.Equal(t, Flatten(Map(slice, mapFn)), FlatMap(slice, mapFn))
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []string = nil
+		words := FlatMap(slice, strings.Fields)
+		assert.Nil(t, words)
+	})
+}
+
+func BenchmarkFlatMap(b *testing.B) {
+	slice := Generate(1000, func(idx int) int { return idx })
+	mapFn := func(i int) []int { return []int{i, i + 1, i + 2} }
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		FlatMap(slice, mapFn)
+	}
+}
+
+func BenchmarkFlattenMap(b *testing.B) {
+	slice := Generate(1000, func(idx int) int { return idx })
+	mapFn := func(i int) []int { return []int{i, i + 1, i + 2} }
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Flatten(Map(slice, mapFn))
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	t.Run("Flatten integer slice", func(t *testing.T) {
+		slice := [][]int{{1, 2, 3}, {4, 5, 6}, {7, 8}}
+		flat := Flatten(slice)
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, flat)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice [][]int = nil
+		flat := Flatten(slice)
+		assert.Nil(t, flat)
+	})
+}
+
+func TestFlattenWithSeparator(t *testing.T) {
+	t.Run("Flatten with separator between sub-slices", func(t *testing.T) {
+		slice := [][]int{{1, 2}, {3}, {}, {4, 5}}
+		flat := FlattenWithSeparator(slice, []int{0, 0})
+		assert.Equal(t, []int{1, 2, 0, 0, 3, 0, 0, 0, 0, 4, 5}, flat)
+	})
+
+	t.Run("No separator on single sub-slice", func(t *testing.T) {
+		slice := [][]string{{"a", "b"}}
+		flat := FlattenWithSeparator(slice, []string{","})
+		assert.Equal(t, []string{"a", "b"}, flat)
+	})
+
+	t.Run("Flatten with empty separator", func(t *testing.T) {
+		slice := [][]int{{1}, {2}}
+		flat := FlattenWithSeparator(slice, nil)
+		assert.Equal(t, []int{1, 2}, flat)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice [][]int = nil
+		flat := FlattenWithSeparator(slice, []int{0})
+		assert.Nil(t, flat)
+	})
+}
+
+func TestFold(t *testing.T) {
+	t.Run("Calculate sum and factorial", func(t *testing.T) {
+		numbers := []int{1, 2, 3, 4, 5, 6}
+
+		sum := Fold(numbers, 0, func(acc, next int) int {
+			return acc + next
+		})
+		assert.Equal(t, 0+1+2+3+4+5+6, sum)
+
+		factorial := Fold(numbers, 1, func(acc, next int) int {
+			return acc * next
+		})
+		assert.Equal(t, 1*1*2*3*4*5*6, factorial)
+	})
+
+	t.Run("Return initial value on nil slice", func(t *testing.T) {
+		var slice []int = nil
+
+		folded := Fold(slice, 0, func(acc, next int) int {
+			return acc + next
+		})
+		assert.Equal(t, 0, folded)
+
+		folded2 := Fold(slice, 42, func(acc, next int) int {
+			return acc + next
+		})
+		assert.Equal(t, 42, folded2)
+	})
+}
+
+func TestFoldIndexWhile(t *testing.T) {
+	t.Run("Sum values until limit is reached", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4, 5}
+		visited := 0
+		sum := FoldIndexWhile(slice, 0, func(acc int, idx int, val int) (int, bool) {
+			visited++
+			acc += val
+			return acc, acc < 6
+		})
+		assert.Equal(t, 6, sum)
+		assert.Equal(t, 3, visited)
+	})
+
+	t.Run("Fold with indices over whole slice", func(t *testing.T) {
+		slice := []string{"a", "b", "c"}
+		folded := FoldIndexWhile(slice, "", func(acc string, idx int, val string) (string, bool) {
+			return acc + strconv.Itoa(idx) + val, true
+		})
+		assert.Equal(t, "0a1b2c", folded)
+	})
+
+	t.Run("Return initial value on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		folded := FoldIndexWhile(slice, 42, func(acc int, idx int, val int) (int, bool) { return 0, false })
+		assert.Equal(t, 42, folded)
+	})
+}
+
+func TestFoldRight(t *testing.T) {
+	t.Run("Fold from the end", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		right := FoldRight(slice, "", func(val int, acc string) string { return acc + strconv.Itoa(val) })
+		left := Fold(slice, "", func(acc string, val int) string { return acc + strconv.Itoa(val) })
+		assert.Equal(t, "321", right)
+		assert.Equal(t, "123", left)
+	})
+
+	t.Run("Build right-associative expression", func(t *testing.T) {
+		slice := []string{"a", "b", "c"}
+		expr := FoldRight(slice, "nil", func(val string, acc string) string { return "(" + val + " " + acc + ")" })
+		assert.Equal(t, "(a (b (c nil)))", expr)
+	})
+
+	t.Run("Return initial value on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		folded := FoldRight(slice, 42, func(val int, acc int) int { return acc + val })
+		assert.Equal(t, 42, folded)
+	})
+}
+
+func TestForEachPair(t *testing.T) {
+	t.Run("Compute deltas", func(t *testing.T) {
+		slice := []int{1, 4, 9, 16}
+		deltas := make([]int, 0)
+		ForEachPair(slice, func(prev, cur int) { deltas = append(deltas, cur-prev) })
+		assert.Equal(t, []int{3, 5, 7}, deltas)
+	})
+
+	t.Run("Do nothing on single element", func(t *testing.T) {
+		calls := 0
+		ForEachPair([]int{1}, func(prev, cur int) { calls++ })
+		assert.Equal(t, 0, calls)
+	})
+
+	t.Run("Do nothing on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		calls := 0
+		ForEachPair(slice, func(prev, cur int) { calls++ })
+		assert.Equal(t, 0, calls)
+	})
+}
+
+func TestFrequencies(t *testing.T) {
+	t.Run("Count integer frequencies", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4, 0, 1, 4, 0, 0, 12, 3, 5, 7, 1}
+		frequencies := Frequencies(slice)
+		assert.Equal(t, map[int]int{
+			1:  3,
+			2:  1,
+			3:  2,
+			4:  2,
+			0:  3,
+			12: 1,
+			5:  1,
+			7:  1},
+			frequencies,
+		)
+	})
+
+	t.Run("Empty map on empty slice", func(t *testing.T) {
+		slice := []int{}
+		frequencies := Frequencies(slice)
+		assert.Equal(t, map[int]int{}, frequencies)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		frequencies := Frequencies(slice)
+		assert.Nil(t, frequencies)
+	})
+}
+
+func TestFromChannel(t *testing.T) {
+	t.Run("Receive values until closed", func(t *testing.T) {
+		ch := make(chan int)
+		go func() {
+			for i := 0; i < 5; i++ {
+				ch <- i
+			}
+			close(ch)
+		}()
+		assert.Equal(t, []int{0, 1, 2, 3, 4}, FromChannel(ch))
+	})
+
+	t.Run("Return empty slice on closed channel", func(t *testing.T) {
+		ch := make(chan int)
+		close(ch)
+		assert.Equal(t, []int{}, FromChannel(ch))
 	})
 }
 
@@ -357,371 +1793,2556 @@ func TestGenerate(t *testing.T) {
 		assert.Equal(t, []int{0, 1, 2, 3, 4}, slice)
 	})
 
-	t.Run("Generate empty slice", func(t *testing.T) {
-		slice := Generate(0, func(idx int) int { return idx })
-		assert.Equal(t, []int{}, slice)
+	t.Run("Generate empty slice", func(t *testing.T) {
+		slice := Generate(0, func(idx int) int { return idx })
+		assert.Equal(t, []int{}, slice)
+	})
+}
+
+func TestGenerate2D(t *testing.T) {
+	t.Run("Generate multiplication table", func(t *testing.T) {
+		table := Generate2D(3, 4, func(r, c int) int { return (r + 1) * (c + 1) })
+		assert.Equal(t, [][]int{
+			{1, 2, 3, 4},
+			{2, 4, 6, 8},
+			{3, 6, 9, 12},
+		}, table)
+	})
+
+	t.Run("Generate rows without columns", func(t *testing.T) {
+		matrix := Generate2D(2, 0, func(r, c int) int { return 0 })
+		assert.Equal(t, [][]int{{}, {}}, matrix)
+	})
+
+	t.Run("Generate empty matrix", func(t *testing.T) {
+		matrix := Generate2D(0, 3, func(r, c int) int { return 0 })
+		assert.Equal(t, [][]int{}, matrix)
+	})
+
+	t.Run("Panic on negative dimensions", func(t *testing.T) {
+		assert.Panics(t, func() { Generate2D(-1, 1, func(r, c int) int { return 0 }) })
+		assert.Panics(t, func() { Generate2D(1, -1, func(r, c int) int { return 0 }) })
+	})
+}
+
+func TestGenerateWhile(t *testing.T) {
+	t.Run("Generate until value is rejected", func(t *testing.T) {
+		squares := GenerateWhile(func(idx int) int { return idx * idx }, func(val int) bool { return val < 30 })
+		assert.Equal(t, []int{0, 1, 4, 9, 16, 25}, squares)
+	})
+
+	t.Run("Generate fixed number of values", func(t *testing.T) {
+		calls := 0
+		slice := GenerateWhile(func(idx int) int { calls++; return idx }, func(val int) bool { return val < 3 })
+		assert.Equal(t, []int{0, 1, 2}, slice)
+		assert.Equal(t, 4, calls)
+	})
+
+	t.Run("Return empty slice on immediate stop", func(t *testing.T) {
+		slice := GenerateWhile(func(idx int) int { return idx }, func(int) bool { return false })
+		assert.Equal(t, []int{}, slice)
+	})
+
+	t.Run("Panic on nil functions", func(t *testing.T) {
+		assert.Panics(t, func() { GenerateWhile(nil, func(int) bool { return true }) })
+		assert.Panics(t, func() { GenerateWhile(func(idx int) int { return idx }, nil) })
+	})
+}
+
+func TestGroupBy(t *testing.T) {
+	type person struct {
+		name string
+		city string
+	}
+
+	t.Run("Group people by city", func(t *testing.T) {
+		people := []person{{"foo", "Oulu"}, {"bar", "Turku"}, {"baz", "Oulu"}, {"qux", "Oulu"}}
+		groups := GroupBy(people, func(p person) string { return p.city })
+		assert.Equal(t, map[string][]person{
+			"Oulu":  {{"foo", "Oulu"}, {"baz", "Oulu"}, {"qux", "Oulu"}},
+			"Turku": {{"bar", "Turku"}},
+		}, groups)
+	})
+
+	t.Run("Group integers by parity", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4, 5}
+		groups := GroupBy(slice, func(i int) bool { return i%2 == 0 })
+		assert.Equal(t, map[bool][]int{
+			true:  {2, 4},
+			false: {1, 3, 5},
+		}, groups)
+	})
+
+	t.Run("Empty map on empty slice", func(t *testing.T) {
+		groups := GroupBy([]int{}, func(i int) int { return i })
+		assert.Equal(t, map[int][]int{}, groups)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		groups := GroupBy(slice, func(i int) int { return i })
+		assert.Nil(t, groups)
+	})
+}
+
+func TestHead(t *testing.T) {
+	t.Run("Return first element", func(t *testing.T) {
+		head, ok := Head([]int{1, 2, 3})
+		assert.True(t, ok)
+		assert.Equal(t, 1, head)
+	})
+
+	t.Run("Return single element", func(t *testing.T) {
+		head, ok := Head([]int{7})
+		assert.True(t, ok)
+		assert.Equal(t, 7, head)
+	})
+
+	t.Run("Return false on empty slice", func(t *testing.T) {
+		_, ok := Head([]int{})
+		assert.False(t, ok)
+	})
+
+	t.Run("Return false on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		head, ok := Head(slice)
+		assert.False(t, ok)
+		assert.Equal(t, 0, head)
+	})
+}
+
+func TestIndexOf(t *testing.T) {
+	t.Run("Return index of first occurrence", func(t *testing.T) {
+		slice := []int{1, 2, 3, 2}
+		idx, found := IndexOf(slice, 2)
+		assert.Equal(t, 1, idx)
+		assert.True(t, found)
+	})
+
+	t.Run("Return zero and false when not found", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		idx, found := IndexOf(slice, 4)
+		assert.Equal(t, 0, idx)
+		assert.False(t, found)
+	})
+
+	t.Run("Return zero and false on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		idx, found := IndexOf(slice, 1)
+		assert.Equal(t, 0, idx)
+		assert.False(t, found)
+	})
+}
+
+func TestInit(t *testing.T) {
+	t.Run("Return all but last element", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2}, Init([]int{1, 2, 3}))
+	})
+
+	t.Run("Appending does not overwrite last element", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		_ = append(Init(slice), 9)
+		assert.Equal(t, []int{1, 2, 3}, slice)
+	})
+
+	t.Run("Return empty slice on single element slice", func(t *testing.T) {
+		assert.Equal(t, []int{}, Init([]int{1}))
+	})
+
+	t.Run("Return empty slice on empty slice", func(t *testing.T) {
+		assert.Equal(t, []int{}, Init([]int{}))
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		assert.Nil(t, Init(slice))
+	})
+}
+
+func TestInsert(t *testing.T) {
+	t.Run("Insert at the head", func(t *testing.T) {
+		assert.Equal(t, []int{8, 9, 1, 2, 3}, Insert([]int{1, 2, 3}, 0, 8, 9))
+	})
+
+	t.Run("Insert in the middle", func(t *testing.T) {
+		assert.Equal(t, []int{1, 8, 9, 2, 3}, Insert([]int{1, 2, 3}, 1, 8, 9))
+	})
+
+	t.Run("Insert at the tail", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2, 3, 8, 9}, Insert([]int{1, 2, 3}, 3, 8, 9))
+	})
+
+	t.Run("Original slice is not modified", func(t *testing.T) {
+		slice := make([]int, 3, 10)
+		Insert(slice, 1, 7)
+		assert.Equal(t, []int{0, 0, 0, 0}, slice[:4])
+	})
+
+	t.Run("Insert into nil slice", func(t *testing.T) {
+		assert.Equal(t, []int{1}, Insert(nil, 0, 1))
+	})
+
+	t.Run("Panic on out of range index", func(t *testing.T) {
+		assert.PanicsWithValue(t, "sliceutils: Insert index out of range", func() { Insert([]int{1}, 2, 0) })
+		assert.PanicsWithValue(t, "sliceutils: Insert index out of range", func() { Insert([]int{1}, -1, 0) })
+	})
+}
+
+func TestInterleave(t *testing.T) {
+	t.Run("Interleave slices of equal lengths", func(t *testing.T) {
+		interleaved := Interleave([]int{1, 2, 3}, []int{4, 5, 6}, []int{7, 8, 9})
+		assert.Equal(t, []int{1, 4, 7, 2, 5, 8, 3, 6, 9}, interleaved)
+	})
+
+	t.Run("Interleave slices of unequal lengths", func(t *testing.T) {
+		assert.Equal(t, []int{1, 4, 2, 5, 3}, Interleave([]int{1, 2, 3}, []int{4, 5}))
+		assert.Equal(t, []int{1, 2, 4, 3}, Interleave([]int{1}, []int{2, 3}, []int{4}))
+	})
+
+	t.Run("Return copy of single slice", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2, 3}, Interleave([]int{1, 2, 3}))
+	})
+
+	t.Run("Skip nil slices", func(t *testing.T) {
+		assert.Equal(t, []int{1, 3, 2}, Interleave([]int{1, 2}, nil, []int{3}))
+	})
+
+	t.Run("Return empty slice on nil slices", func(t *testing.T) {
+		assert.Equal(t, []int{}, Interleave[int](nil, nil))
+	})
+
+	t.Run("Return nil on no arguments", func(t *testing.T) {
+		assert.Nil(t, Interleave[int]())
+	})
+}
+
+func TestIntersection(t *testing.T) {
+	t.Run("Intersection of two overlapping sets", func(t *testing.T) {
+		a := []int{1, 2, 3}
+		b := []int{3, 2, 6}
+		intersection := Intersection(a, b)
+		assert.Equal(t, []int{2, 3}, intersection)
+	})
+
+	t.Run("Intersection of two non-overlapping sets", func(t *testing.T) {
+		a := []int{1, 2, 3}
+		b := []int{5, 4, 6}
+		intersection := Intersection(a, b)
+		assert.Equal(t, []int{}, intersection)
+	})
+
+	t.Run("Return nil when both sets are nil", func(t *testing.T) {
+		intersection := Intersection[int](nil, nil)
+		assert.Nil(t, intersection)
+	})
+}
+
+func TestIntersectionBy(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	id := func(u user) int { return u.id }
+
+	t.Run("Intersection of structs by ID", func(t *testing.T) {
+		a := []user{{1, "a"}, {2, "b"}, {3, "c"}}
+		b := []user{{3, "x"}, {2, "y"}, {4, "z"}}
+		assert.Equal(t, []user{{2, "b"}, {3, "c"}}, IntersectionBy(a, b, id))
+	})
+
+	t.Run("Intersection of non-overlapping sets", func(t *testing.T) {
+		a := []user{{1, "a"}}
+		b := []user{{2, "b"}}
+		assert.Equal(t, []user{}, IntersectionBy(a, b, id))
+	})
+
+	t.Run("Return nil when both sets are nil", func(t *testing.T) {
+		assert.Nil(t, IntersectionBy(nil, nil, id))
+	})
+}
+
+func TestIntersectionN(t *testing.T) {
+	t.Run("Intersection of three overlapping sets", func(t *testing.T) {
+		a := []int{1, 2, 3, 4, 5}
+		b := []int{5, 4, 3, 2}
+		c := []int{2, 6, 4, 5}
+		assert.Equal(t, []int{2, 4, 5}, IntersectionN(a, b, c))
+	})
+
+	t.Run("Remove duplicates keeping first-seen order", func(t *testing.T) {
+		a := []int{3, 1, 3, 2, 1}
+		b := []int{1, 2, 3}
+		assert.Equal(t, []int{3, 1, 2}, IntersectionN(a, b))
+	})
+
+	t.Run("Return deduplicated slice on single slice", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2, 3}, IntersectionN([]int{1, 2, 1, 3, 2}))
+	})
+
+	t.Run("Return empty slice when any set is empty", func(t *testing.T) {
+		assert.Equal(t, []int{}, IntersectionN([]int{1, 2}, []int{}, []int{1}))
+	})
+
+	t.Run("Return nil on no slices", func(t *testing.T) {
+		assert.Nil(t, IntersectionN[int]())
+	})
+
+	t.Run("Return nil when all sets are nil", func(t *testing.T) {
+		assert.Nil(t, IntersectionN[int](nil, nil, nil))
+	})
+}
+
+func TestIntersectionOrdered(t *testing.T) {
+	t.Run("Keep order and duplicates of left slice", func(t *testing.T) {
+		a := []int{3, 1, 2, 3, 4, 1}
+		b := []int{1, 3, 3}
+		intersection := IntersectionOrdered(a, b)
+		assert.Equal(t, []int{3, 1, 3, 1}, intersection)
+	})
+
+	t.Run("Intersection of two non-overlapping slices", func(t *testing.T) {
+		a := []int{1, 2, 2}
+		b := []int{5, 4, 6}
+		intersection := IntersectionOrdered(a, b)
+		assert.Equal(t, []int{}, intersection)
+	})
+
+	t.Run("Return empty slice when one slice is nil", func(t *testing.T) {
+		intersection := IntersectionOrdered([]int{1, 2}, nil)
+		assert.Equal(t, []int{}, intersection)
+	})
+
+	t.Run("Return nil when both slices are nil", func(t *testing.T) {
+		intersection := IntersectionOrdered[int](nil, nil)
+		assert.Nil(t, intersection)
+	})
+}
+
+func TestIsSet(t *testing.T) {
+	t.Run("Is slice with only unique elements a set", func(t *testing.T) {
+		set := []string{"foo", "bar", "hello", "world", "baz"}
+		isSet := IsSet(set)
+		assert.True(t, isSet)
+	})
+
+	t.Run("Is slice with repeating elements a set", func(t *testing.T) {
+		notSet := []string{"foo", "bar", "baz", "foo", "hello"}
+		isSet := IsSet(notSet)
+		assert.False(t, isSet)
+	})
+
+	t.Run("Return true on nil slice", func(t *testing.T) {
+		var set []string = nil
+		isSet := IsSet(set)
+		assert.True(t, isSet)
+	})
+}
+
+func TestIsSortedBy(t *testing.T) {
+	t.Run("Is sorted by with sorted slices", func(t *testing.T) {
+		sortedSlice := []int{1, 2, 3, 4, 4, 5, 6, 7, 8}
+		sorted := IsSortedBy(sortedSlice, func(lhs, rhs int) bool {
+			return lhs < rhs
+		})
+		assert.True(t, sorted)
+
+		sortedSlice2 := []string{"bar", "baz", "foo", "hello", "world"}
+		sorted2 := IsSortedBy(sortedSlice2, func(lhs, rhs string) bool { return lhs < rhs })
+		assert.True(t, sorted2)
+
+		reverseSortedSlice := Reverse(sortedSlice)
+		reverseSorted := IsSortedBy(reverseSortedSlice, func(lhs, rhs int) bool { return lhs > rhs })
+		assert.True(t, reverseSorted)
+	})
+
+	t.Run("Is sorted by with unsorted slices", func(t *testing.T) {
+		unsortedSlice := []int{1, 2, 3, 4, 5, 4, 6, 7, 8}
+		sorted := IsSortedBy(unsortedSlice, func(lhs, rhs int) bool {
+			return lhs < rhs
+		})
+		assert.False(t, sorted)
+
+		unsortedSlice2 := []string{"baz", "bar", "foo", "hello", "world"}
+		sorted2 := IsSortedBy(unsortedSlice2, func(lhs, rhs string) bool { return lhs < rhs })
+		assert.False(t, sorted2)
+	})
+}
+
+func TestIsSubSet(t *testing.T) {
+	t.Run("IsSubSet on subset", func(t *testing.T) {
+		super := []int{1, 2, 3}
+		sub := []int{1, 2}
+		assert.True(t, IsSubSet(sub, super))
+	})
+
+	t.Run("IsSubSet on non-subset", func(t *testing.T) {
+		super := []int{1, 2, 3}
+		notSub := []int{1, 2, 4}
+		assert.False(t, IsSubSet(notSub, super))
+	})
+
+	t.Run("Equal set is subset of itself", func(t *testing.T) {
+		set := []int{1, 2, 3}
+		assert.True(t, IsSubSet(set, set))
+	})
+
+	t.Run("True on nil sets", func(t *testing.T) {
+		assert.True(t, IsSubSet[int](nil, nil))
+	})
+}
+
+func TestIsSuperSet(t *testing.T) {
+	t.Run("IsSuperSet on superset", func(t *testing.T) {
+		super := []int{1, 2, 3}
+		sub := []int{1, 2}
+		assert.True(t, IsSuperSet(super, sub))
+	})
+
+	t.Run("IsSuperSet on non-superset", func(t *testing.T) {
+		notSuper := []int{1, 2, 3}
+		sub := []int{1, 2, 4}
+		assert.False(t, IsSuperSet(notSuper, sub))
+	})
+
+	t.Run("Equal set is superset of itself", func(t *testing.T) {
+		set := []int{1, 2, 3}
+		assert.True(t, IsSuperSet(set, set))
+	})
+
+	t.Run("True on nil sets", func(t *testing.T) {
+		assert.True(t, IsSubSet[int](nil, nil))
+	})
+}
+
+func TestJoin(t *testing.T) {
+	t.Run("Join variadics", func(t *testing.T) {
+		slice1 := []int{1, 2, 3}
+		slice2 := []int{4, 5, 6}
+		slice3 := []int{7, 8}
+
+		joined := Join(slice1, slice2, slice3)
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, joined)
+	})
+
+	t.Run("Join two dimensional slice", func(t *testing.T) {
+		slice := [][]int{{1, 2, 3}, {4, 5, 6}, {7, 8}}
+		joined := Join(slice...)
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, joined)
+	})
+
+	t.Run("Return empty slice on nil slices", func(t *testing.T) {
+		var slice []int = nil
+		var slice2 []int = nil
+		joined := Join(slice, slice2)
+		assert.Equal(t, []int{}, joined)
+	})
+
+	t.Run("Return nil on no arguments", func(t *testing.T) {
+		joined := Join[int]()
+		assert.Nil(t, joined)
+	})
+}
+
+func TestLast(t *testing.T) {
+	t.Run("Return last element", func(t *testing.T) {
+		last, ok := Last([]int{1, 2, 3})
+		assert.True(t, ok)
+		assert.Equal(t, 3, last)
+	})
+
+	t.Run("Return single element", func(t *testing.T) {
+		last, ok := Last([]int{7})
+		assert.True(t, ok)
+		assert.Equal(t, 7, last)
+	})
+
+	t.Run("Return false on empty slice", func(t *testing.T) {
+		_, ok := Last([]int{})
+		assert.False(t, ok)
+	})
+
+	t.Run("Return false on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		last, ok := Last(slice)
+		assert.False(t, ok)
+		assert.Equal(t, 0, last)
+	})
+}
+
+func TestLastIndexOf(t *testing.T) {
+	t.Run("Return index of last occurrence", func(t *testing.T) {
+		slice := []int{1, 2, 3, 2}
+		idx, found := LastIndexOf(slice, 2)
+		assert.Equal(t, 3, idx)
+		assert.True(t, found)
+	})
+
+	t.Run("Return zero and false when not found", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		idx, found := LastIndexOf(slice, 4)
+		assert.Equal(t, 0, idx)
+		assert.False(t, found)
+	})
+
+	t.Run("Return zero and false on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		idx, found := LastIndexOf(slice, 1)
+		assert.Equal(t, 0, idx)
+		assert.False(t, found)
+	})
+}
+
+func TestLeastCommon(t *testing.T) {
+	t.Run("Return least common words", func(t *testing.T) {
+		slice := strings.Fields("the cat and the dog and the bird")
+		leastCommon := LeastCommon(slice, 3)
+		assert.Equal(t, []Pair[string, int]{
+			{First: "cat", Second: 1},
+			{First: "dog", Second: 1},
+			{First: "bird", Second: 1},
+		}, leastCommon)
+	})
+
+	t.Run("Clamp n to the number of distinct elements", func(t *testing.T) {
+		slice := []int{1, 1, 2}
+		leastCommon := LeastCommon(slice, 5)
+		assert.Equal(t, []Pair[int, int]{{First: 2, Second: 1}, {First: 1, Second: 2}}, leastCommon)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		leastCommon := LeastCommon(slice, 2)
+		assert.Nil(t, leastCommon)
+	})
+}
+
+func TestMap(t *testing.T) {
+	t.Run("Map strings to their byte lengths", func(t *testing.T) {
+		slice := []string{"bar", "", "f", "hello", "world"}
+		lengths := Map(slice, func(s string) int { return len(s) })
+		assert.Equal(t, []int{3, 0, 1, 5, 5}, lengths)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []string = nil
+		outSlice := Map(slice, func(s string) int { return len(s) })
+		assert.Nil(t, outSlice)
+	})
+}
+
+func TestMapChannel(t *testing.T) {
+	t.Run("Map values in order", func(t *testing.T) {
+		in := ToChannel([]int{1, 2, 3})
+		out := MapChannel(in, func(i int) string { return strconv.Itoa(i * 2) })
+		assert.Equal(t, []string{"2", "4", "6"}, FromChannel(out))
+	})
+
+	t.Run("Close output when input closes", func(t *testing.T) {
+		in := make(chan int)
+		out := MapChannel(in, func(i int) int { return i + 1 })
+		in <- 1
+		assert.Equal(t, 2, <-out)
+		close(in)
+		_, ok := <-out
+		assert.False(t, ok)
+	})
+
+	t.Run("Closed input yields closed output", func(t *testing.T) {
+		in := make(chan int)
+		close(in)
+		_, ok := <-MapChannel(in, func(i int) int { return i })
+		assert.False(t, ok)
+	})
+
+	t.Run("Panic on nil mapping function", func(t *testing.T) {
+		assert.PanicsWithValue(t, "sliceutils: nil MapChannel mapping function", func() {
+			MapChannel[int, int](make(chan int), nil)
+		})
+	})
+}
+
+func TestMapInPlace(t *testing.T) {
+	t.Run("Integers incremented", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		MapInPlace(slice, func(i int) int { return i + 1 })
+		assert.Equal(t, []int{2, 3, 4}, slice)
+	})
+
+	t.Run("Strings capitalized", func(t *testing.T) {
+		slice := []string{"bar", "", "f", "hello", "world"}
+		MapInPlace(slice, func(s string) string { return strings.ToUpper(s) })
+		assert.Equal(t, []string{"BAR", "", "F", "HELLO", "WORLD"}, slice)
+	})
+
+	t.Run("Do nothing on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		MapInPlace(slice, func(i int) int { return i })
+		assert.Nil(t, slice)
+	})
+}
+
+func TestMapIndexed(t *testing.T) {
+	t.Run("Map indices to values", func(t *testing.T) {
+		slice := make([]string, 4)
+		indices := MapIndexed(slice, func(idx int, s string) int { return idx })
+		assert.Equal(t, []int{0, 1, 2, 3}, indices)
+	})
+
+	t.Run("Label strings with their indices", func(t *testing.T) {
+		slice := []string{"foo", "bar"}
+		labels := MapIndexed(slice, func(idx int, s string) string { return strconv.Itoa(idx) + ": " + s })
+		assert.Equal(t, []string{"0: foo", "1: bar"}, labels)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []string = nil
+		labels := MapIndexed(slice, func(idx int, s string) string { return s })
+		assert.Nil(t, labels)
+	})
+}
+
+func TestMapInto(t *testing.T) {
+	t.Run("Reuse destination with enough capacity", func(t *testing.T) {
+		dst := make([]int, 2, 5)
+		src := []string{"foo", "", "hello"}
+		out := MapInto(dst, src, func(s string) int { return len(s) })
+		assert.Equal(t, []int{3, 0, 5}, out)
+		assert.Same(t, &dst[:1][0], &out[0])
+	})
+
+	t.Run("Allocate when destination is too small", func(t *testing.T) {
+		dst := []int{9}
+		src := []string{"foo", "ab"}
+		out := MapInto(dst, src, func(s string) int { return len(s) })
+		assert.Equal(t, []int{3, 2}, out)
+		assert.Equal(t, []int{9}, dst)
+	})
+
+	t.Run("Reuse buffer across calls without allocating", func(t *testing.T) {
+		src := []int{1, 2, 3}
+		buf := MapInto(nil, src, func(i int) int { return i * 2 })
+		allocs := testing.AllocsPerRun(10, func() {
+			buf = MapInto(buf, src, func(i int) int { return i * 3 })
+		})
+		assert.Equal(t, 0.0, allocs)
+		assert.Equal(t, []int{3, 6, 9}, buf)
+	})
+
+	t.Run("Return empty destination on empty source", func(t *testing.T) {
+		dst := []int{1, 2}
+		out := MapInto(dst, []int{}, func(i int) int { return i })
+		assert.Equal(t, []int{}, out)
+	})
+
+	t.Run("Return nil on nil slices", func(t *testing.T) {
+		out := MapInto[int, int](nil, nil, func(i int) int { return i })
+		assert.Nil(t, out)
+	})
+}
+
+func TestMaxBy(t *testing.T) {
+	t.Run("Return max from slice", func(t *testing.T) {
+		slice := []int{4, 5, 7, 3, 9, -1, 3, 4, 7, 12, 43, 10, 5}
+		max, ok := MaxBy(slice, func(lhs, rhs int) bool {
+			return lhs < rhs
+		})
+		assert.True(t, ok)
+		assert.Equal(t, 43, max)
+	})
+
+	t.Run("Return zero value and false on empty slice", func(t *testing.T) {
+		slice := []int{}
+		max, ok := MaxBy(slice, func(lhs, rhs int) bool {
+			return lhs < rhs
+		})
+		assert.False(t, ok)
+		assert.Zero(t, max)
+	})
+}
+
+func TestMaxOf(t *testing.T) {
+	t.Run("Return largest of integers", func(t *testing.T) {
+		assert.Equal(t, 7, MaxOf(3, 7, -1, 5))
+	})
+
+	t.Run("Return largest of strings", func(t *testing.T) {
+		assert.Equal(t, "foo", MaxOf("bar", "foo", "baz"))
+	})
+
+	t.Run("Return the only argument", func(t *testing.T) {
+		assert.Equal(t, 1.5, MaxOf(1.5))
+	})
+}
+
+func TestMedian(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	t.Run("Median of odd-length slice", func(t *testing.T) {
+		median, ok := Median([]int{5, 1, 4, 2, 3}, less)
+		assert.True(t, ok)
+		assert.Equal(t, 3, median)
+	})
+
+	t.Run("Lower middle element of even-length slice", func(t *testing.T) {
+		median, ok := Median([]int{4, 1, 3, 2}, less)
+		assert.True(t, ok)
+		assert.Equal(t, 2, median)
+	})
+
+	t.Run("Median of single element slice", func(t *testing.T) {
+		median, ok := Median([]int{7}, less)
+		assert.True(t, ok)
+		assert.Equal(t, 7, median)
+	})
+
+	t.Run("Original slice is not modified", func(t *testing.T) {
+		slice := []int{3, 1, 2}
+		Median(slice, less)
+		assert.Equal(t, []int{3, 1, 2}, slice)
+	})
+
+	t.Run("Return false on empty slice", func(t *testing.T) {
+		_, ok := Median([]int{}, less)
+		assert.False(t, ok)
+	})
+
+	t.Run("Return false on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		median, ok := Median(slice, less)
+		assert.False(t, ok)
+		assert.Equal(t, 0, median)
+	})
+}
+
+func TestMergeSorted(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	t.Run("Merge interleaving slices", func(t *testing.T) {
+		merged := MergeSorted([]int{1, 4, 6, 9}, []int{2, 3, 7}, less)
+		assert.Equal(t, []int{1, 2, 3, 4, 6, 7, 9}, merged)
+	})
+
+	t.Run("Merge with one empty slice", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2}, MergeSorted([]int{1, 2}, []int{}, less))
+		assert.Equal(t, []int{1, 2}, MergeSorted(nil, []int{1, 2}, less))
+	})
+
+	t.Run("Equal elements of left slice come first", func(t *testing.T) {
+		type item struct {
+			key  int
+			side string
+		}
+		lhs := []item{{1, "a"}, {2, "a"}}
+		rhs := []item{{1, "b"}, {2, "b"}}
+		merged := MergeSorted(lhs, rhs, func(a, b item) bool { return a.key < b.key })
+		assert.Equal(t, []item{{1, "a"}, {1, "b"}, {2, "a"}, {2, "b"}}, merged)
+	})
+
+	t.Run("Return empty slice on empty slices", func(t *testing.T) {
+		assert.Equal(t, []int{}, MergeSorted([]int{}, []int{}, less))
+	})
+
+	t.Run("Return nil if both slices are nil", func(t *testing.T) {
+		assert.Nil(t, MergeSorted(nil, nil, less))
+	})
+
+	t.Run("Panic on nil comparison function", func(t *testing.T) {
+		assert.Panics(t, func() { MergeSorted([]int{1}, []int{2}, nil) })
+	})
+
+	t.Run("Do not call comparison function if either slice is empty", func(t *testing.T) {
+		assert.Equal(t, []int{1}, MergeSorted([]int{1}, nil, nil))
+		assert.Equal(t, []int{2}, MergeSorted([]int{}, []int{2}, nil))
+	})
+}
+
+func TestMinBy(t *testing.T) {
+	t.Run("Return min from slice", func(t *testing.T) {
+		slice := []int{4, 5, 7, 3, 9, -1, 3, 4, 7, 12, 43, 10, 5}
+		min, ok := MinBy(slice, func(lhs, rhs int) bool {
+			return lhs < rhs
+		})
+		assert.True(t, ok)
+		assert.Equal(t, -1, min)
+	})
+
+	t.Run("Return zero value and false on empty slice", func(t *testing.T) {
+		slice := []int{}
+		min, ok := MinBy(slice, func(lhs, rhs int) bool {
+			return lhs < rhs
+		})
+		assert.False(t, ok)
+		assert.Zero(t, min)
+	})
+}
+
+func TestMinOf(t *testing.T) {
+	t.Run("Return smallest of integers", func(t *testing.T) {
+		assert.Equal(t, -1, MinOf(3, 7, -1, 5))
+	})
+
+	t.Run("Return smallest of strings", func(t *testing.T) {
+		assert.Equal(t, "bar", MinOf("foo", "bar", "baz"))
+	})
+
+	t.Run("Return the only argument", func(t *testing.T) {
+		assert.Equal(t, 1.5, MinOf(1.5))
+	})
+}
+
+func TestMode(t *testing.T) {
+	t.Run("Return clear mode", func(t *testing.T) {
+		mode, count, ok := Mode([]string{"a", "b", "b", "c", "b", "a"})
+		assert.True(t, ok)
+		assert.Equal(t, "b", mode)
+		assert.Equal(t, 3, count)
+	})
+
+	t.Run("Return element first reaching maximum count on tie", func(t *testing.T) {
+		mode, count, ok := Mode([]int{1, 2, 2, 1})
+		assert.True(t, ok)
+		assert.Equal(t, 2, mode)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("Return first element when all are unique", func(t *testing.T) {
+		mode, count, ok := Mode([]int{3, 1, 2})
+		assert.True(t, ok)
+		assert.Equal(t, 3, mode)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("Return false on empty slice", func(t *testing.T) {
+		_, _, ok := Mode([]int{})
+		assert.False(t, ok)
+	})
+
+	t.Run("Return false on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		mode, count, ok := Mode(slice)
+		assert.False(t, ok)
+		assert.Equal(t, 0, mode)
+		assert.Equal(t, 0, count)
+	})
+}
+
+func TestMostCommon(t *testing.T) {
+	t.Run("Return most common words", func(t *testing.T) {
+		slice := strings.Fields("the cat and the dog and the bird")
+		mostCommon := MostCommon(slice, 2)
+		assert.Equal(t, []Pair[string, int]{
+			{First: "the", Second: 3},
+			{First: "and", Second: 2},
+		}, mostCommon)
+	})
+
+	t.Run("Order ties by first appearance", func(t *testing.T) {
+		slice := []int{3, 1, 2, 1, 3, 2}
+		mostCommon := MostCommon(slice, 3)
+		assert.Equal(t, []Pair[int, int]{
+			{First: 3, Second: 2},
+			{First: 1, Second: 2},
+			{First: 2, Second: 2},
+		}, mostCommon)
+	})
+
+	t.Run("Clamp n to the number of distinct elements", func(t *testing.T) {
+		slice := []int{1, 1, 2}
+		assert.Equal(t, []Pair[int, int]{{First: 1, Second: 2}, {First: 2, Second: 1}}, MostCommon(slice, 5))
+		assert.Equal(t, []Pair[int, int]{}, MostCommon(slice, -1))
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		mostCommon := MostCommon(slice, 2)
+		assert.Nil(t, mostCommon)
+	})
+}
+
+func TestMul(t *testing.T) {
+	t.Run("Multiply slices of equal lengths", func(t *testing.T) {
+		assert.Equal(t, []int{4, 10, 18}, Mul([]int{1, 2, 3}, []int{4, 5, 6}))
+	})
+
+	t.Run("Multiply up to the shorter length", func(t *testing.T) {
+		assert.Equal(t, []int{2}, Mul([]int{1}, []int{2, 3}))
+	})
+
+	t.Run("Return empty slice on empty slice", func(t *testing.T) {
+		assert.Equal(t, []int{}, Mul([]int{1}, []int{}))
+	})
+
+	t.Run("Return nil if either slice is nil", func(t *testing.T) {
+		assert.Nil(t, Mul([]int{1}, nil))
+	})
+}
+
+func TestNth(t *testing.T) {
+	slice := []int{10, 20, 30}
+
+	t.Run("Return element at valid index", func(t *testing.T) {
+		val, ok := Nth(slice, 1)
+		assert.True(t, ok)
+		assert.Equal(t, 20, val)
+	})
+
+	t.Run("Count negative index from the end", func(t *testing.T) {
+		last, ok := Nth(slice, -1)
+		assert.True(t, ok)
+		assert.Equal(t, 30, last)
+
+		first, ok := Nth(slice, -3)
+		assert.True(t, ok)
+		assert.Equal(t, 10, first)
+	})
+
+	t.Run("Return false on out of range index", func(t *testing.T) {
+		_, ok := Nth(slice, 3)
+		assert.False(t, ok)
+		_, ok = Nth(slice, -4)
+		assert.False(t, ok)
+	})
+
+	t.Run("Return false on nil slice", func(t *testing.T) {
+		val, ok := Nth[int](nil, 0)
+		assert.False(t, ok)
+		assert.Equal(t, 0, val)
+	})
+}
+
+func TestNthOr(t *testing.T) {
+	slice := []string{"a", "b"}
+
+	t.Run("Return element at valid index", func(t *testing.T) {
+		assert.Equal(t, "b", NthOr(slice, 1, "z"))
+		assert.Equal(t, "a", NthOr(slice, -2, "z"))
+	})
+
+	t.Run("Return fallback on out of range index", func(t *testing.T) {
+		assert.Equal(t, "z", NthOr(slice, 2, "z"))
+		assert.Equal(t, "z", NthOr(slice, -3, "z"))
+		assert.Equal(t, "z", NthOr(nil, 0, "z"))
+	})
+}
+
+func TestPairs(t *testing.T) {
+	t.Run("Pair even-length slice", func(t *testing.T) {
+		slice := []string{"name", "foo", "color", "red"}
+		pairs := Pairs(slice)
+		assert.Equal(t, []Pair[string, string]{
+			{First: "name", Second: "foo"},
+			{First: "color", Second: "red"},
+		}, pairs)
+	})
+
+	t.Run("Drop trailing element of odd-length slice", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		pairs := Pairs(slice)
+		assert.Equal(t, []Pair[int, int]{{First: 1, Second: 2}}, pairs)
+	})
+
+	t.Run("Return empty slice on single element", func(t *testing.T) {
+		pairs := Pairs([]int{1})
+		assert.Equal(t, []Pair[int, int]{}, pairs)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		pairs := Pairs(slice)
+		assert.Nil(t, pairs)
+	})
+}
+
+func TestPairwise(t *testing.T) {
+	t.Run("Pair two elements", func(t *testing.T) {
+		assert.Equal(t, []Pair[int, int]{{1, 2}}, Pairwise([]int{1, 2}))
+	})
+
+	t.Run("Pair adjacent elements with overlap", func(t *testing.T) {
+		pairs := Pairwise([]int{1, 4, 9, 16})
+		assert.Equal(t, []Pair[int, int]{{1, 4}, {4, 9}, {9, 16}}, pairs)
+	})
+
+	t.Run("Compute deltas from pairs", func(t *testing.T) {
+		deltas := Map(Pairwise([]int{1, 4, 9, 16}), func(p Pair[int, int]) int {
+			return p.Second - p.First
+		})
+		assert.Equal(t, []int{3, 5, 7}, deltas)
+	})
+
+	t.Run("Return empty slice on single element slice", func(t *testing.T) {
+		assert.Equal(t, []Pair[int, int]{}, Pairwise([]int{1}))
+	})
+
+	t.Run("Return empty slice on empty slice", func(t *testing.T) {
+		assert.Equal(t, []Pair[int, int]{}, Pairwise([]int{}))
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		assert.Nil(t, Pairwise(slice))
+	})
+}
+
+func TestPartition(t *testing.T) {
+	t.Run("Partition by integer parity", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+		even, odd := Partition(slice, func(i int) bool { return i%2 == 0 })
+		assert.Equal(t, []int{2, 4, 6, 8, 10}, even)
+		assert.Equal(t, []int{1, 3, 5, 7, 9}, odd)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		even, odd := Partition(slice, func(i int) bool { return i%2 == 0 })
+		assert.Nil(t, even)
+		assert.Nil(t, odd)
+	})
+}
+
+func TestPartitionInPlace(t *testing.T) {
+	t.Run("Partition with even number of elements", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+		idx := PartitionInPlace(slice, func(i int) bool { return i%2 == 0 })
+		assert.Equal(t, []int{10, 2, 8, 4, 6}, slice[:idx])
+		assert.Equal(t, []int{5, 7, 3, 9, 1}, slice[idx:])
+	})
+
+	t.Run("Partition with odd number of elements", func(t *testing.T) {
+		slice := []int{1, 3, 4, -1, -5, 10, 9, -4, -3}
+		idx := PartitionInPlace(slice, func(i int) bool { return i > 0 })
+		assert.Equal(t, []int{1, 3, 4, 9, 10}, slice[:idx])
+		assert.Equal(t, []int{-5, -1, -4, -3}, slice[idx:])
+	})
+
+	t.Run("Partition with single element", func(t *testing.T) {
+		slice := []int{1}
+		idx := PartitionInPlace(slice, func(i int) bool { return i > 0 })
+		assert.Equal(t, []int{1}, slice[:idx])
+		assert.Equal(t, []int{}, slice[idx:])
+	})
+
+	t.Run("Do nothing on empty slice and return zero index", func(t *testing.T) {
+		slice := []int{}
+		idx := PartitionInPlace(slice, func(i int) bool { return i%2 == 0 })
+		assert.Equal(t, 0, idx)
+	})
+
+	t.Run("Do nothing on nil slice and return zero index", func(t *testing.T) {
+		var slice []int = nil
+		idx := PartitionInPlace(slice, func(i int) bool { return i%2 == 0 })
+		assert.Equal(t, 0, idx)
+	})
+}
+
+func TestPartitionMap(t *testing.T) {
+	parse := func(s string) (int, error, bool) {
+		n, err := strconv.Atoi(s)
+		return n, err, err == nil
+	}
+
+	t.Run("Route values to both sides", func(t *testing.T) {
+		nums, errs := PartitionMap([]string{"1", "x", "3", "", "5"}, parse)
+		assert.Equal(t, []int{1, 3, 5}, nums)
+		assert.Len(t, errs, 2)
+		for _, err := range errs {
+			assert.Error(t, err)
+		}
+	})
+
+	t.Run("Route all values to left", func(t *testing.T) {
+		nums, errs := PartitionMap([]string{"1", "2"}, parse)
+		assert.Equal(t, []int{1, 2}, nums)
+		assert.Equal(t, []error{}, errs)
+	})
+
+	t.Run("Return nil slices on nil slice", func(t *testing.T) {
+		var slice []string = nil
+		nums, errs := PartitionMap(slice, parse)
+		assert.Nil(t, nums)
+		assert.Nil(t, errs)
+	})
+
+	t.Run("Panic on nil partition function", func(t *testing.T) {
+		assert.Panics(t, func() { PartitionMap[int, int, int]([]int{1}, nil) })
+	})
+}
+
+func TestRange(t *testing.T) {
+	t.Run("Ascending range", func(t *testing.T) {
+		assert.Equal(t, []int{0, 1, 2, 3}, Range(0, 4, 1))
+		assert.Equal(t, []int{1, 4, 7}, Range(1, 9, 3))
+	})
+
+	t.Run("Descending range with negative step", func(t *testing.T) {
+		assert.Equal(t, []int{5, 3, 1}, Range(5, 0, -2))
+	})
+
+	t.Run("Range of unsigned integers", func(t *testing.T) {
+		assert.Equal(t, []uint8{250, 252, 254}, Range[uint8](250, 255, 2))
+	})
+
+	t.Run("Stop before integer overflow", func(t *testing.T) {
+		assert.Equal(t, []int8{120, 125}, Range[int8](120, 127, 5))
+		assert.Equal(t, []int8{-120, -125}, Range[int8](-120, -128, -5))
+	})
+
+	t.Run("Return empty slice on empty range", func(t *testing.T) {
+		assert.Equal(t, []int{}, Range(3, 3, 1))
+	})
+
+	t.Run("Return empty slice when step points away from stop", func(t *testing.T) {
+		assert.Equal(t, []int{}, Range(0, 5, -1))
+		assert.Equal(t, []int{}, Range(5, 0, 1))
+	})
+
+	t.Run("Panic on zero step", func(t *testing.T) {
+		assert.PanicsWithValue(t, "sliceutils: zero Range step", func() { Range(0, 5, 0) })
+	})
+}
+
+func TestReduce(t *testing.T) {
+	t.Run("Reduce to maximum", func(t *testing.T) {
+		slice := []int{3, 7, 1, 5}
+		max, ok := Reduce(slice, func(a, b int) int {
+			if b > a {
+				return b
+			}
+			return a
+		})
+		assert.Equal(t, 7, max)
+		assert.True(t, ok)
+	})
+
+	t.Run("Reduce by concatenation", func(t *testing.T) {
+		slice := []string{"foo", "bar", "baz"}
+		joined, ok := Reduce(slice, func(a, b string) string { return a + "," + b })
+		assert.Equal(t, "foo,bar,baz", joined)
+		assert.True(t, ok)
+	})
+
+	t.Run("Return single element untouched", func(t *testing.T) {
+		calls := 0
+		reduced, ok := Reduce([]int{42}, func(a, b int) int {
+			calls++
+			return a + b
+		})
+		assert.Equal(t, 42, reduced)
+		assert.True(t, ok)
+		assert.Equal(t, 0, calls)
+	})
+
+	t.Run("Return zero value and false on empty slice", func(t *testing.T) {
+		reduced, ok := Reduce([]int{}, func(a, b int) int { return a + b })
+		assert.Equal(t, 0, reduced)
+		assert.False(t, ok)
+	})
+
+	t.Run("Return zero value and false on nil slice", func(t *testing.T) {
+		var slice []string = nil
+		reduced, ok := Reduce(slice, func(a, b string) string { return a + b })
+		assert.Equal(t, "", reduced)
+		assert.False(t, ok)
+	})
+}
+
+func TestRemoveAll(t *testing.T) {
+	t.Run("Remove multiple matches", func(t *testing.T) {
+		assert.Equal(t, []int{1, 3, 4}, RemoveAll([]int{2, 1, 2, 3, 2, 4}, 2))
+	})
+
+	t.Run("Remove single match", func(t *testing.T) {
+		assert.Equal(t, []int{1, 3}, RemoveAll([]int{1, 2, 3}, 2))
+	})
+
+	t.Run("Return copy on no match", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		removed := RemoveAll(slice, 5)
+		assert.Equal(t, []int{1, 2, 3}, removed)
+		removed[0] = 9
+		assert.Equal(t, []int{1, 2, 3}, slice)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		assert.Nil(t, RemoveAll(slice, 1))
+	})
+}
+
+func TestRemoveAllInPlace(t *testing.T) {
+	t.Run("Remove multiple matches", func(t *testing.T) {
+		slice := []int{2, 1, 2, 3, 2, 4}
+		removed := RemoveAllInPlace(&slice, 2)
+		assert.Equal(t, 3, removed)
+		assert.Equal(t, []int{1, 3, 4}, slice)
+		assert.Len(t, slice, 3)
+	})
+
+	t.Run("Remove nothing on no match", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		removed := RemoveAllInPlace(&slice, 5)
+		assert.Equal(t, 0, removed)
+		assert.Equal(t, []int{1, 2, 3}, slice)
+	})
+
+	t.Run("Do nothing on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		assert.Equal(t, 0, RemoveAllInPlace(&slice, 1))
+		assert.Nil(t, slice)
+	})
+
+	t.Run("Do nothing on nil slice pointer", func(t *testing.T) {
+		assert.Equal(t, 0, RemoveAllInPlace[int](nil, 1))
+	})
+}
+
+func TestRemoveAt(t *testing.T) {
+	t.Run("Remove first element", func(t *testing.T) {
+		assert.Equal(t, []int{2, 3}, RemoveAt([]int{1, 2, 3}, 0))
+	})
+
+	t.Run("Remove interior element", func(t *testing.T) {
+		assert.Equal(t, []int{1, 3}, RemoveAt([]int{1, 2, 3}, 1))
+	})
+
+	t.Run("Remove last element", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2}, RemoveAt([]int{1, 2, 3}, 2))
+	})
+
+	t.Run("Original slice is not modified", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		RemoveAt(slice, 0)
+		assert.Equal(t, []int{1, 2, 3}, slice)
+	})
+
+	t.Run("Panic on invalid index", func(t *testing.T) {
+		assert.PanicsWithValue(t, "sliceutils: RemoveAt index out of range", func() { RemoveAt([]int{1}, 1) })
+		assert.PanicsWithValue(t, "sliceutils: RemoveAt index out of range", func() { RemoveAt([]int{1}, -1) })
+		assert.PanicsWithValue(t, "sliceutils: RemoveAt index out of range", func() { RemoveAt[int](nil, 0) })
+	})
+}
+
+func TestRemoveRange(t *testing.T) {
+	t.Run("Remove leading range", func(t *testing.T) {
+		assert.Equal(t, []int{3, 4}, RemoveRange([]int{1, 2, 3, 4}, 0, 2))
+	})
+
+	t.Run("Remove interior range", func(t *testing.T) {
+		assert.Equal(t, []int{1, 4}, RemoveRange([]int{1, 2, 3, 4}, 1, 3))
+	})
+
+	t.Run("Remove trailing range", func(t *testing.T) {
+		assert.Equal(t, []int{1}, RemoveRange([]int{1, 2, 3, 4}, 1, 4))
+	})
+
+	t.Run("Remove empty range", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2}, RemoveRange([]int{1, 2}, 1, 1))
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		assert.Nil(t, RemoveRange(slice, 0, 0))
+	})
+
+	t.Run("Panic on invalid range", func(t *testing.T) {
+		msg := "sliceutils: RemoveRange index out of range"
+		assert.PanicsWithValue(t, msg, func() { RemoveRange([]int{1, 2}, -1, 1) })
+		assert.PanicsWithValue(t, msg, func() { RemoveRange([]int{1, 2}, 0, 3) })
+		assert.PanicsWithValue(t, msg, func() { RemoveRange([]int{1, 2}, 2, 1) })
+	})
+}
+
+func TestRemoveValue(t *testing.T) {
+	t.Run("Remove first of multiple matches", func(t *testing.T) {
+		assert.Equal(t, []int{1, 3, 2}, RemoveValue([]int{1, 2, 3, 2}, 2))
+	})
+
+	t.Run("Remove single match", func(t *testing.T) {
+		assert.Equal(t, []string{"a", "c"}, RemoveValue([]string{"a", "b", "c"}, "b"))
+	})
+
+	t.Run("Return copy on no match", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		removed := RemoveValue(slice, 5)
+		assert.Equal(t, []int{1, 2, 3}, removed)
+		removed[0] = 9
+		assert.Equal(t, []int{1, 2, 3}, slice)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		assert.Nil(t, RemoveValue(slice, 1))
+	})
+}
+
+func TestRepeat(t *testing.T) {
+	t.Run("Repeat several times", func(t *testing.T) {
+		repeated := Repeat([]int{1, 2}, 3)
+		assert.Equal(t, []int{1, 2, 1, 2, 1, 2}, repeated)
+		assert.Equal(t, 6, cap(repeated))
+	})
+
+	t.Run("Return copy on count of one", func(t *testing.T) {
+		slice := []int{1, 2}
+		repeated := Repeat(slice, 1)
+		assert.Equal(t, []int{1, 2}, repeated)
+		repeated[0] = 9
+		assert.Equal(t, []int{1, 2}, slice)
+	})
+
+	t.Run("Return empty slice on zero count", func(t *testing.T) {
+		assert.Equal(t, []int{}, Repeat([]int{1, 2}, 0))
+		assert.Equal(t, []int{}, Repeat([]int{1, 2}, -1))
+	})
+
+	t.Run("Return empty slice on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		assert.Equal(t, []int{}, Repeat(slice, 3))
+	})
+}
+
+func TestReplaceAllSubslice(t *testing.T) {
+	t.Run("Replace all occurrences", func(t *testing.T) {
+		slice := []int{1, 2, 3, 1, 2, 4}
+		replaced := ReplaceAllSubslice(slice, []int{1, 2}, []int{9})
+		assert.Equal(t, []int{9, 3, 9, 4}, replaced)
+		assert.Equal(t, []int{1, 2, 3, 1, 2, 4}, slice)
+	})
+
+	t.Run("Replace non-overlapping occurrences", func(t *testing.T) {
+		slice := []int{1, 1, 1, 1, 1}
+		replaced := ReplaceAllSubslice(slice, []int{1, 1}, []int{2})
+		assert.Equal(t, []int{2, 2, 1}, replaced)
+	})
+
+	t.Run("Remove occurrences with empty replacement", func(t *testing.T) {
+		slice := []string{"a", "-", "b", "-", "c"}
+		replaced := ReplaceAllSubslice(slice, []string{"-"}, nil)
+		assert.Equal(t, []string{"a", "b", "c"}, replaced)
+	})
+
+	t.Run("Return copy on empty old subslice", func(t *testing.T) {
+		slice := []int{1, 2}
+		replaced := ReplaceAllSubslice(slice, []int{}, []int{3})
+		assert.Equal(t, []int{1, 2}, replaced)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		replaced := ReplaceAllSubslice(slice, []int{1}, []int{2})
+		assert.Nil(t, replaced)
+	})
+}
+
+func TestReplaceSubslice(t *testing.T) {
+	t.Run("Replace first occurrence", func(t *testing.T) {
+		slice := []int{1, 2, 3, 1, 2, 4}
+		replaced := ReplaceSubslice(slice, []int{1, 2}, []int{7, 8, 9})
+		assert.Equal(t, []int{7, 8, 9, 3, 1, 2, 4}, replaced)
+		assert.Equal(t, []int{1, 2, 3, 1, 2, 4}, slice)
+	})
+
+	t.Run("Return copy when not found", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		replaced := ReplaceSubslice(slice, []int{2, 1}, []int{0})
+		assert.Equal(t, []int{1, 2, 3}, replaced)
+	})
+
+	t.Run("Return copy on empty old subslice", func(t *testing.T) {
+		slice := []int{1, 2}
+		replaced := ReplaceSubslice(slice, nil, []int{3})
+		assert.Equal(t, []int{1, 2}, replaced)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		replaced := ReplaceSubslice(slice, []int{1}, []int{2})
+		assert.Nil(t, replaced)
+	})
+}
+
+func TestReverse(t *testing.T) {
+	t.Run("Reverse integer slice", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4, 5}
+		reversed := Reverse(slice)
+		assert.Equal(t, []int{5, 4, 3, 2, 1}, reversed)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		output := Reverse(slice)
+		assert.Nil(t, output)
+	})
+}
+
+func TestReverseInPlace(t *testing.T) {
+	t.Run("Reverse integer slice", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4, 5}
+		ReverseInPlace(slice)
+		assert.Equal(t, []int{5, 4, 3, 2, 1}, slice)
+	})
+
+	t.Run("Do nothing on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		ReverseInPlace(slice)
+		assert.Nil(t, slice)
+	})
+}
+
+func TestRollingReduce(t *testing.T) {
+	type avgState struct {
+		sum   int
+		count int
+	}
+	initAvg := func() avgState { return avgState{} }
+	addAvg := func(s avgState, val int) avgState { return avgState{s.sum + val, s.count + 1} }
+	removeAvg := func(s avgState, val int) avgState { return avgState{s.sum - val, s.count - 1} }
+	resultAvg := func(s avgState) float64 { return float64(s.sum) / float64(s.count) }
+
+	t.Run("Moving average", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4, 5, 6}
+		averages := RollingReduce(slice, 3, initAvg, addAvg, removeAvg, resultAvg)
+		assert.Equal(t, []float64{2, 3, 4, 5}, averages)
+	})
+
+	t.Run("Match WindowReduce", func(t *testing.T) {
+		slice := []int{5, -2, 7, 1, 0, 3, 3}
+		sum := func(acc, val int) int { return acc + val }
+		rolling := RollingReduce(slice, 4,
+			func() int { return 0 },
+			sum,
+			func(acc, val int) int { return acc - val },
+			func(acc int) int { return acc })
+		windowed := WindowReduce(slice, 4, func(window []int) int { return Fold(window, 0, sum) })
+		assert.Equal(t, windowed, rolling)
+	})
+
+	t.Run("Return empty slice when size is larger than length", func(t *testing.T) {
+		averages := RollingReduce([]int{1, 2}, 3, initAvg, addAvg, removeAvg, resultAvg)
+		assert.Equal(t, []float64{}, averages)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		averages := RollingReduce(slice, 2, initAvg, addAvg, removeAvg, resultAvg)
+		assert.Nil(t, averages)
+	})
+
+	t.Run("Panic on non-positive size", func(t *testing.T) {
+		assert.Panics(t, func() { RollingReduce([]int{1}, 0, initAvg, addAvg, removeAvg, resultAvg) })
+	})
+}
+
+func TestSample(t *testing.T) {
+	t.Run("Sample has no repeated elements", func(t *testing.T) {
+		slice := Generate(100, func(idx int) int { return idx })
+		sample := Sample(slice, 30, rand.New(rand.NewSource(1)))
+		assert.Len(t, sample, 30)
+		assert.True(t, IsSet(sample))
+		assert.True(t, IsSubSet(sample, slice))
+	})
+
+	t.Run("Seeded source gives reproducible samples", func(t *testing.T) {
+		slice := Generate(100, func(idx int) int { return idx })
+		a := Sample(slice, 10, rand.New(rand.NewSource(42)))
+		b := Sample(slice, 10, rand.New(rand.NewSource(42)))
+		assert.Equal(t, a, b)
+	})
+
+	t.Run("Return shuffled copy when n is at least length", func(t *testing.T) {
+		slice := Generate(20, func(idx int) int { return idx })
+		sample := Sample(slice, 25, rand.New(rand.NewSource(1)))
+		assert.ElementsMatch(t, slice, sample)
+		assert.NotEqual(t, slice, sample)
+	})
+
+	t.Run("Original slice is not modified", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4, 5}
+		Sample(slice, 3, rand.New(rand.NewSource(1)))
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, slice)
+	})
+
+	t.Run("Return empty slice on non-positive n", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		assert.Equal(t, []int{}, Sample(slice, 0, rand.New(rand.NewSource(1))))
+		assert.Equal(t, []int{}, Sample(slice, -1, rand.New(rand.NewSource(1))))
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		assert.Nil(t, Sample(slice, 2, rand.New(rand.NewSource(1))))
+	})
+}
+
+func TestSamplePartition(t *testing.T) {
+	t.Run("Split into sample and rest", func(t *testing.T) {
+		slice := Generate(10, func(idx int) int { return idx })
+		sample, rest := SamplePartition(slice, 3, rand.New(rand.NewSource(1)))
+		assert.Len(t, sample, 3)
+		assert.Len(t, rest, 7)
+		assert.ElementsMatch(t, slice, Join(sample, rest))
+	})
+
+	t.Run("Same seed produces same split", func(t *testing.T) {
+		slice := Generate(10, func(idx int) int { return idx })
+		sample1, rest1 := SamplePartition(slice, 4, rand.New(rand.NewSource(7)))
+		sample2, rest2 := SamplePartition(slice, 4, rand.New(rand.NewSource(7)))
+		assert.Equal(t, sample1, sample2)
+		assert.Equal(t, rest1, rest2)
+	})
+
+	t.Run("Clamp n between zero and length", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		sample, rest := SamplePartition(slice, 5, rand.New(rand.NewSource(1)))
+		assert.ElementsMatch(t, slice, sample)
+		assert.Empty(t, rest)
+
+		sample, rest = SamplePartition(slice, -1, rand.New(rand.NewSource(1)))
+		assert.Empty(t, sample)
+		assert.ElementsMatch(t, slice, rest)
+	})
+
+	t.Run("Return nil slices on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		sample, rest := SamplePartition(slice, 2, rand.New(rand.NewSource(1)))
+		assert.Nil(t, sample)
+		assert.Nil(t, rest)
+	})
+}
+
+func TestShuffle(t *testing.T) {
+	t.Run("Shuffled slice is a permutation", func(t *testing.T) {
+		slice := Generate(100, func(idx int) int { return idx })
+		shuffled := Shuffle(slice, rand.New(rand.NewSource(1)))
+		assert.ElementsMatch(t, slice, shuffled)
+		assert.NotEqual(t, slice, shuffled)
+	})
+
+	t.Run("Original slice is not modified", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4, 5}
+		Shuffle(slice, rand.New(rand.NewSource(1)))
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, slice)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		shuffled := Shuffle(slice, rand.New(rand.NewSource(1)))
+		assert.Nil(t, shuffled)
+	})
+}
+
+func TestShuffleSeeded(t *testing.T) {
+	t.Run("Same seed produces same order", func(t *testing.T) {
+		slice := Generate(100, func(idx int) int { return idx })
+		assert.Equal(t, ShuffleSeeded(slice, 42), ShuffleSeeded(slice, 42))
+		assert.Equal(t, Shuffle(slice, rand.New(rand.NewSource(42))), ShuffleSeeded(slice, 42))
+	})
+
+	t.Run("Different seeds produce different orders", func(t *testing.T) {
+		slice := Generate(100, func(idx int) int { return idx })
+		assert.NotEqual(t, ShuffleSeeded(slice, 1), ShuffleSeeded(slice, 2))
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		shuffled := ShuffleSeeded(slice, 1)
+		assert.Nil(t, shuffled)
+	})
+}
+
+func TestSortBy(t *testing.T) {
+	t.Run("Sort integers in ascending order", func(t *testing.T) {
+		slice := []int{3, 1, 4, 1, 5}
+		sorted := SortBy(slice, func(a, b int) bool { return a < b })
+		assert.Equal(t, []int{1, 1, 3, 4, 5}, sorted)
+		assert.Equal(t, []int{3, 1, 4, 1, 5}, slice)
+	})
+
+	t.Run("Keep order of equal elements", func(t *testing.T) {
+		slice := []string{"bb", "a", "cc", "d"}
+		sorted := SortBy(slice, func(a, b string) bool { return len(a) < len(b) })
+		assert.Equal(t, []string{"a", "d", "bb", "cc"}, sorted)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		sorted := SortBy(slice, func(a, b int) bool { return a < b })
+		assert.Nil(t, sorted)
+	})
+}
+
+func TestSortInPlace(t *testing.T) {
+	t.Run("Match SortBy", func(t *testing.T) {
+		slice := []int{5, -2, 7, 1, 0, 3, 3}
+		less := func(a, b int) bool { return a > b }
+		sorted := SortBy(slice, less)
+		SortInPlace(slice, less)
+		assert.Equal(t, sorted, slice)
+		assert.True(t, IsSortedBy(slice, less))
+	})
+
+	t.Run("Keep order of equal elements", func(t *testing.T) {
+		slice := []string{"bb", "a", "cc", "d"}
+		SortInPlace(slice, func(a, b string) bool { return len(a) < len(b) })
+		assert.Equal(t, []string{"a", "d", "bb", "cc"}, slice)
+	})
+
+	t.Run("Do nothing on empty slice", func(t *testing.T) {
+		slice := []int{}
+		SortInPlace(slice, func(a, b int) bool { return a < b })
+		assert.Equal(t, []int{}, slice)
+	})
+
+	t.Run("Do nothing on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		SortInPlace(slice, func(a, b int) bool { return a < b })
+		assert.Nil(t, slice)
+	})
+}
+
+func TestSortedDifferenceBy(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	t.Run("Difference of two overlapping sorted sets", func(t *testing.T) {
+		a := []int{1, 2, 3, 5, 8}
+		b := []int{2, 3, 4, 8, 9}
+		difference := SortedDifferenceBy(a, b, less)
+		assert.Equal(t, []int{1, 5}, difference)
+	})
+
+	t.Run("Difference of two non-overlapping sorted sets", func(t *testing.T) {
+		a := []int{1, 2, 3}
+		b := []int{4, 5, 6}
+		difference := SortedDifferenceBy(a, b, less)
+		assert.Equal(t, []int{1, 2, 3}, difference)
+	})
+
+	t.Run("Match hash-based Difference", func(t *testing.T) {
+		a := []int{0, 2, 4, 6, 8, 10}
+		b := []int{0, 3, 6, 9}
+		assert.Equal(t, Difference(a, b), SortedDifferenceBy(a, b, less))
+	})
+
+	t.Run("Return nil when both sets are nil", func(t *testing.T) {
+		difference := SortedDifferenceBy(nil, nil, less)
+		assert.Nil(t, difference)
+	})
+}
+
+func TestSortedIntersectionBy(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	t.Run("Intersection of two overlapping sorted sets", func(t *testing.T) {
+		a := []int{1, 2, 3, 5, 8}
+		b := []int{2, 3, 4, 8, 9}
+		intersection := SortedIntersectionBy(a, b, less)
+		assert.Equal(t, []int{2, 3, 8}, intersection)
+	})
+
+	t.Run("Take equal elements from left set", func(t *testing.T) {
+		a := []string{"Bar", "Foo"}
+		b := []string{"foo"}
+		intersection := SortedIntersectionBy(a, b, func(a, b string) bool {
+			return strings.ToLower(a) < strings.ToLower(b)
+		})
+		assert.Equal(t, []string{"Foo"}, intersection)
+	})
+
+	t.Run("Intersection of two non-overlapping sorted sets", func(t *testing.T) {
+		a := []int{1, 2, 3}
+		b := []int{4, 5, 6}
+		intersection := SortedIntersectionBy(a, b, less)
+		assert.Equal(t, []int{}, intersection)
+	})
+
+	t.Run("Return nil when both sets are nil", func(t *testing.T) {
+		intersection := SortedIntersectionBy(nil, nil, less)
+		assert.Nil(t, intersection)
+	})
+}
+
+func TestSortedUnionBy(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	t.Run("Union of two overlapping sorted sets", func(t *testing.T) {
+		a := []int{1, 2, 3, 5, 8}
+		b := []int{2, 3, 4, 8, 9}
+		union := SortedUnionBy(a, b, less)
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 8, 9}, union)
+	})
+
+	t.Run("Preserve left set on empty right set", func(t *testing.T) {
+		a := []int{1, 2, 3}
+		union := SortedUnionBy(a, []int{}, less)
+		assert.Equal(t, []int{1, 2, 3}, union)
+	})
+
+	t.Run("Empty set on empty sets", func(t *testing.T) {
+		union := SortedUnionBy([]int{}, []int{}, less)
+		assert.Equal(t, []int{}, union)
+	})
+
+	t.Run("Return nil when both sets are nil", func(t *testing.T) {
+		union := SortedUnionBy(nil, nil, less)
+		assert.Nil(t, union)
+	})
+}
+
+func TestSpanBy(t *testing.T) {
+	isPositive := func(i int) bool { return i > 0 }
+
+	t.Run("Split in the middle", func(t *testing.T) {
+		prefix, rest := SpanBy([]int{3, 1, -2, 4, -5}, isPositive)
+		assert.Equal(t, []int{3, 1}, prefix)
+		assert.Equal(t, []int{-2, 4, -5}, rest)
+	})
+
+	t.Run("Empty prefix", func(t *testing.T) {
+		prefix, rest := SpanBy([]int{-1, 2}, isPositive)
+		assert.Equal(t, []int{}, prefix)
+		assert.Equal(t, []int{-1, 2}, rest)
+	})
+
+	t.Run("Whole slice as prefix", func(t *testing.T) {
+		prefix, rest := SpanBy([]int{1, 2}, isPositive)
+		assert.Equal(t, []int{1, 2}, prefix)
+		assert.Equal(t, []int{}, rest)
+	})
+
+	t.Run("Appending to prefix does not overwrite rest", func(t *testing.T) {
+		slice := []int{1, -1}
+		prefix, _ := SpanBy(slice, isPositive)
+		_ = append(prefix, 9)
+		assert.Equal(t, []int{1, -1}, slice)
+	})
+
+	t.Run("Return nil slices on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		prefix, rest := SpanBy(slice, isPositive)
+		assert.Nil(t, prefix)
+		assert.Nil(t, rest)
+	})
+
+	t.Run("Panic on nil predicate function", func(t *testing.T) {
+		assert.Panics(t, func() { SpanBy([]int{1}, nil) })
+	})
+}
+
+func TestSplitBy(t *testing.T) {
+	t.Run("Split on separators", func(t *testing.T) {
+		slice := []int{1, 2, 0, 3, 0, 4, 5}
+		assert.Equal(t, [][]int{{1, 2}, {3}, {4, 5}}, SplitBy(slice, 0))
+	})
+
+	t.Run("Consecutive separators produce empty sub-slice", func(t *testing.T) {
+		slice := []int{1, 0, 0, 2}
+		assert.Equal(t, [][]int{{1}, {}, {2}}, SplitBy(slice, 0))
+	})
+
+	t.Run("Leading and trailing separators produce empty sub-slices", func(t *testing.T) {
+		slice := []string{",", "a", ","}
+		assert.Equal(t, [][]string{{}, {"a"}, {}}, SplitBy(slice, ","))
+	})
+
+	t.Run("Return whole slice when there are no separators", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		assert.Equal(t, [][]int{{1, 2, 3}}, SplitBy(slice, 0))
+	})
+
+	t.Run("Appending to sub-slice does not overwrite separator", func(t *testing.T) {
+		slice := []int{1, 0, 2}
+		parts := SplitBy(slice, 0)
+		_ = append(parts[0], 9)
+		assert.Equal(t, []int{1, 0, 2}, slice)
+	})
+
+	t.Run("Return single empty sub-slice on empty slice", func(t *testing.T) {
+		assert.Equal(t, [][]int{{}}, SplitBy([]int{}, 0))
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		assert.Nil(t, SplitBy(slice, 0))
+	})
+}
+
+func TestSplitByKeyChange(t *testing.T) {
+	t.Run("Split rows by column value", func(t *testing.T) {
+		rows := [][]string{{"a", "1"}, {"a", "2"}, {"b", "3"}, {"c", "4"}, {"c", "5"}}
+		segments := SplitByKeyChange(rows, func(row []string) string { return row[0] })
+		assert.Equal(t, [][][]string{
+			{{"a", "1"}, {"a", "2"}},
+			{{"b", "3"}},
+			{{"c", "4"}, {"c", "5"}},
+		}, segments)
+	})
+
+	t.Run("Split when key changes back to earlier value", func(t *testing.T) {
+		slice := []int{1, 3, 2, 4, 5}
+		segments := SplitByKeyChange(slice, func(i int) bool { return i%2 == 0 })
+		assert.Equal(t, [][]int{{1, 3}, {2, 4}, {5}}, segments)
+	})
+
+	t.Run("Appending to segment does not overwrite next segment", func(t *testing.T) {
+		slice := []int{1, 1, 2}
+		segments := SplitByKeyChange(slice, func(i int) int { return i })
+		_ = append(segments[0], 9)
+		assert.Equal(t, []int{1, 1, 2}, slice)
+	})
+
+	t.Run("Return empty slice on empty slice", func(t *testing.T) {
+		segments := SplitByKeyChange([]int{}, func(i int) int { return i })
+		assert.Equal(t, [][]int{}, segments)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		segments := SplitByKeyChange(slice, func(i int) int { return i })
+		assert.Nil(t, segments)
+	})
+}
+
+func TestSplitFunc(t *testing.T) {
+	isSep := func(r rune) bool { return r == ',' || r == ';' }
+
+	t.Run("Split on any of several separators", func(t *testing.T) {
+		slice := []rune("ab,c;d")
+		assert.Equal(t, [][]rune{[]rune("ab"), []rune("c"), []rune("d")}, SplitFunc(slice, isSep))
+	})
+
+	t.Run("Adjacent separators produce empty sub-slices", func(t *testing.T) {
+		slice := []rune("a,;,b")
+		assert.Equal(t, [][]rune{[]rune("a"), {}, {}, []rune("b")}, SplitFunc(slice, isSep))
+	})
+
+	t.Run("Separators at boundaries produce empty sub-slices", func(t *testing.T) {
+		slice := []rune(";a;")
+		assert.Equal(t, [][]rune{{}, []rune("a"), {}}, SplitFunc(slice, isSep))
+	})
+
+	t.Run("Return whole slice when there are no separators", func(t *testing.T) {
+		slice := []rune("abc")
+		assert.Equal(t, [][]rune{[]rune("abc")}, SplitFunc(slice, isSep))
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []rune = nil
+		assert.Nil(t, SplitFunc(slice, isSep))
+	})
+
+	t.Run("Panic on nil separator function", func(t *testing.T) {
+		assert.Panics(t, func() { SplitFunc([]int{1}, nil) })
 	})
 }
 
-func TestIntersection(t *testing.T) {
-	t.Run("Intersection of two overlapping sets", func(t *testing.T) {
+func TestSub(t *testing.T) {
+	t.Run("Subtract slices of equal lengths", func(t *testing.T) {
+		assert.Equal(t, []int{-3, 3, 0}, Sub([]int{1, 8, 6}, []int{4, 5, 6}))
+	})
+
+	t.Run("Subtract up to the shorter length", func(t *testing.T) {
+		assert.Equal(t, []uint{1, 2}, Sub([]uint{3, 4, 5}, []uint{2, 2}))
+	})
+
+	t.Run("Return empty slice on empty slice", func(t *testing.T) {
+		assert.Equal(t, []int{}, Sub([]int{}, []int{}))
+	})
+
+	t.Run("Return nil if either slice is nil", func(t *testing.T) {
+		assert.Nil(t, Sub[int](nil, nil))
+	})
+}
+
+func TestSymmetricDifference(t *testing.T) {
+	t.Run("Symmetric difference on two overlapping sets", func(t *testing.T) {
 		a := []int{1, 2, 3}
 		b := []int{3, 2, 6}
-		intersection := Intersection(a, b)
-		assert.Equal(t, []int{2, 3}, intersection)
+		symmDiff := SymmetricDifference(a, b)
+		assert.Equal(t, []int{1, 6}, symmDiff)
 	})
 
-	t.Run("Intersection of two non-overlapping sets", func(t *testing.T) {
+	t.Run("Preserve left set on empty right set", func(t *testing.T) {
 		a := []int{1, 2, 3}
-		b := []int{5, 4, 6}
-		intersection := Intersection(a, b)
-		assert.Equal(t, []int{}, intersection)
+		b := []int{}
+		symmDiff := SymmetricDifference(a, b)
+		assert.Equal(t, []int{1, 2, 3}, symmDiff)
+	})
+
+	t.Run("Empty set on empty sets", func(t *testing.T) {
+		a := []int{}
+		b := []int{}
+		symmDiff := SymmetricDifference(a, b)
+		assert.Equal(t, []int{}, symmDiff)
 	})
 
 	t.Run("Return nil when both sets are nil", func(t *testing.T) {
-		intersection := Intersection[int](nil, nil)
-		assert.Nil(t, intersection)
+		symmDiff := SymmetricDifference[int](nil, nil)
+		assert.Nil(t, symmDiff)
 	})
 }
 
-func TestIsSet(t *testing.T) {
-	t.Run("Is slice with only unique elements a set", func(t *testing.T) {
-		set := []string{"foo", "bar", "hello", "world", "baz"}
-		isSet := IsSet(set)
-		assert.True(t, isSet)
+func TestTail(t *testing.T) {
+	t.Run("Return all but first element", func(t *testing.T) {
+		assert.Equal(t, []int{2, 3}, Tail([]int{1, 2, 3}))
 	})
 
-	t.Run("Is slice with repeating elements a set", func(t *testing.T) {
-		notSet := []string{"foo", "bar", "baz", "foo", "hello"}
-		isSet := IsSet(notSet)
-		assert.False(t, isSet)
+	t.Run("Return empty slice on single element slice", func(t *testing.T) {
+		assert.Equal(t, []int{}, Tail([]int{1}))
 	})
 
-	t.Run("Return true on nil slice", func(t *testing.T) {
-		var set []string = nil
-		isSet := IsSet(set)
-		assert.True(t, isSet)
+	t.Run("Return empty slice on empty slice", func(t *testing.T) {
+		assert.Equal(t, []int{}, Tail([]int{}))
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		assert.Nil(t, Tail(slice))
 	})
 }
 
-func TestIsSortedBy(t *testing.T) {
-	t.Run("Is sorted by with sorted slices", func(t *testing.T) {
-		sortedSlice := []int{1, 2, 3, 4, 4, 5, 6, 7, 8}
-		sorted := IsSortedBy(sortedSlice, func(lhs, rhs int) bool {
-			return lhs < rhs
+func TestTake(t *testing.T) {
+	t.Run("Take first elements", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4}
+		assert.Equal(t, []int{1, 2}, Take(slice, 2))
+	})
+
+	t.Run("Take nothing with zero", func(t *testing.T) {
+		slice := []int{1, 2}
+		assert.Equal(t, []int{}, Take(slice, 0))
+	})
+
+	t.Run("Take nothing with negative n", func(t *testing.T) {
+		slice := []int{1, 2}
+		assert.Equal(t, []int{}, Take(slice, -1))
+	})
+
+	t.Run("Return whole slice when n is larger than length", func(t *testing.T) {
+		slice := []int{1, 2}
+		assert.Equal(t, []int{1, 2}, Take(slice, 3))
+	})
+
+	t.Run("Result shares backing array", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		Take(slice, 2)[1] = 9
+		assert.Equal(t, []int{1, 9, 3}, slice)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		assert.Nil(t, Take(slice, 1))
+	})
+}
+
+func TestTakeWhile(t *testing.T) {
+	isPositive := func(i int) bool { return i > 0 }
+
+	t.Run("Predicate false in the middle", func(t *testing.T) {
+		slice := []int{1, 2, -3, 4, -5}
+		calls := 0
+		prefix := TakeWhile(slice, func(i int) bool {
+			calls++
+			return isPositive(i)
 		})
-		assert.True(t, sorted)
+		assert.Equal(t, []int{1, 2}, prefix)
+		assert.Equal(t, 3, calls)
+	})
 
-		sortedSlice2 := []string{"bar", "baz", "foo", "hello", "world"}
-		sorted2 := IsSortedBy(sortedSlice2, func(lhs, rhs string) bool { return lhs < rhs })
-		assert.True(t, sorted2)
+	t.Run("Predicate immediately false", func(t *testing.T) {
+		slice := []int{-1, 2}
+		prefix := TakeWhile(slice, isPositive)
+		assert.Equal(t, []int{}, prefix)
+	})
 
-		reverseSortedSlice := Reverse(sortedSlice)
-		reverseSorted := IsSortedBy(reverseSortedSlice, func(lhs, rhs int) bool { return lhs > rhs })
-		assert.True(t, reverseSorted)
+	t.Run("Predicate always true", func(t *testing.T) {
+		slice := []int{1, 2}
+		prefix := TakeWhile(slice, isPositive)
+		assert.Equal(t, []int{1, 2}, prefix)
 	})
 
-	t.Run("Is sorted by with unsorted slices", func(t *testing.T) {
-		unsortedSlice := []int{1, 2, 3, 4, 5, 4, 6, 7, 8}
-		sorted := IsSortedBy(unsortedSlice, func(lhs, rhs int) bool {
-			return lhs < rhs
+	t.Run("Appending to prefix does not modify original slice", func(t *testing.T) {
+		slice := []int{1, -2}
+		prefix := TakeWhile(slice, isPositive)
+		_ = append(prefix, 3)
+		assert.Equal(t, []int{1, -2}, slice)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		prefix := TakeWhile(slice, isPositive)
+		assert.Nil(t, prefix)
+	})
+}
+
+func TestToChannel(t *testing.T) {
+	t.Run("Round-trip slice through channel", func(t *testing.T) {
+		slice := []string{"a", "b", "c"}
+		assert.Equal(t, slice, FromChannel(ToChannel(slice)))
+	})
+
+	t.Run("Channel is buffered and closed", func(t *testing.T) {
+		ch := ToChannel([]int{1, 2})
+		assert.Equal(t, 2, len(ch))
+		assert.Equal(t, 1, <-ch)
+		assert.Equal(t, 2, <-ch)
+		_, ok := <-ch
+		assert.False(t, ok)
+	})
+
+	t.Run("Return closed channel on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		_, ok := <-ToChannel(slice)
+		assert.False(t, ok)
+	})
+}
+
+func TestTopN(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	t.Run("Return largest elements in descending order", func(t *testing.T) {
+		assert.Equal(t, []int{9, 8, 5}, TopN([]int{5, 3, 8, 1, 9, 2}, 3, less))
+	})
+
+	t.Run("Equal elements keep their order", func(t *testing.T) {
+		type item struct{ key, id int }
+		slice := []item{{1, 0}, {2, 1}, {1, 2}, {2, 3}, {1, 4}}
+		top := TopN(slice, 3, func(a, b item) bool { return a.key < b.key })
+		assert.Equal(t, []item{{2, 1}, {2, 3}, {1, 0}}, top)
+	})
+
+	t.Run("Return all elements sorted when n is larger than length", func(t *testing.T) {
+		assert.Equal(t, []int{3, 2, 1}, TopN([]int{3, 1, 2}, 5, less))
+	})
+
+	t.Run("Return empty slice on non-positive n", func(t *testing.T) {
+		assert.Equal(t, []int{}, TopN([]int{3, 1, 2}, -1, less))
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		assert.Nil(t, TopN(slice, 2, less))
+	})
+}
+
+func BenchmarkTopN(b *testing.B) {
+	slice := Shuffle(Generate(100000, func(idx int) int { return idx }), rand.New(rand.NewSource(1)))
+	less := func(a, b int) bool { return a < b }
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		TopN(slice, 10, less)
+	}
+}
+
+func BenchmarkSortByTake(b *testing.B) {
+	slice := Shuffle(Generate(100000, func(idx int) int { return idx }), rand.New(rand.NewSource(1)))
+	greater := func(a, b int) bool { return a > b }
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Take(SortBy(slice, greater), 10)
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	t.Run("Transpose square matrix", func(t *testing.T) {
+		matrix := [][]int{{1, 2}, {3, 4}}
+		assert.Equal(t, [][]int{{1, 3}, {2, 4}}, Transpose(matrix))
+	})
+
+	t.Run("Transpose rectangular matrix", func(t *testing.T) {
+		matrix := [][]int{{1, 2, 3}, {4, 5, 6}}
+		assert.Equal(t, [][]int{{1, 4}, {2, 5}, {3, 6}}, Transpose(matrix))
+	})
+
+	t.Run("Transpose single row", func(t *testing.T) {
+		matrix := [][]int{{1, 2, 3}}
+		assert.Equal(t, [][]int{{1}, {2}, {3}}, Transpose(matrix))
+	})
+
+	t.Run("Truncate ragged matrix to shortest row", func(t *testing.T) {
+		matrix := [][]int{{1, 2, 3}, {4}, {5, 6}}
+		assert.Equal(t, [][]int{{1, 4, 5}}, Transpose(matrix))
+	})
+
+	t.Run("Return empty slice on empty matrix", func(t *testing.T) {
+		assert.Equal(t, [][]int{}, Transpose([][]int{}))
+	})
+
+	t.Run("Return nil on nil matrix", func(t *testing.T) {
+		var matrix [][]int = nil
+		assert.Nil(t, Transpose(matrix))
+	})
+}
+
+func TestTryMap(t *testing.T) {
+	t.Run("Parse all strings successfully", func(t *testing.T) {
+		slice := []string{"1", "-2", "30"}
+		ints, err := TryMap(slice, strconv.Atoi)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, -2, 30}, ints)
+	})
+
+	t.Run("Stop at the first error", func(t *testing.T) {
+		slice := []string{"1", "foo", "3", "bar"}
+		visited := make([]string, 0)
+		ints, err := TryMap(slice, func(s string) (int, error) {
+			visited = append(visited, s)
+			return strconv.Atoi(s)
 		})
-		assert.False(t, sorted)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "foo")
+		assert.Nil(t, ints)
+		assert.Equal(t, []string{"1", "foo"}, visited)
+	})
 
-		unsortedSlice2 := []string{"baz", "bar", "foo", "hello", "world"}
-		sorted2 := IsSortedBy(unsortedSlice2, func(lhs, rhs string) bool { return lhs < rhs })
-		assert.False(t, sorted2)
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []string = nil
+		ints, err := TryMap(slice, strconv.Atoi)
+		assert.NoError(t, err)
+		assert.Nil(t, ints)
 	})
 }
 
-func TestIsSubSet(t *testing.T) {
-	t.Run("IsSubSet on subset", func(t *testing.T) {
-		super := []int{1, 2, 3}
-		sub := []int{1, 2}
-		assert.True(t, IsSubSet(sub, super))
+func TestUnfold(t *testing.T) {
+	t.Run("Generate Fibonacci sequence", func(t *testing.T) {
+		type state struct{ a, b, n int }
+		fib := Unfold(state{0, 1, 0}, func(s state) (int, state, bool) {
+			return s.a, state{s.b, s.a + s.b, s.n + 1}, s.n < 8
+		})
+		assert.Equal(t, []int{0, 1, 1, 2, 3, 5, 8, 13}, fib)
 	})
 
-	t.Run("IsSubSet on non-subset", func(t *testing.T) {
-		super := []int{1, 2, 3}
-		notSub := []int{1, 2, 4}
-		assert.False(t, IsSubSet(notSub, super))
+	t.Run("Thread state between steps", func(t *testing.T) {
+		var states []int
+		digits := Unfold(1234, func(n int) (int, int, bool) {
+			states = append(states, n)
+			return n % 10, n / 10, n > 0
+		})
+		assert.Equal(t, []int{4, 3, 2, 1}, digits)
+		assert.Equal(t, []int{1234, 123, 12, 1, 0}, states)
 	})
 
-	t.Run("Equal set is subset of itself", func(t *testing.T) {
-		set := []int{1, 2, 3}
-		assert.True(t, IsSubSet(set, set))
+	t.Run("Return empty slice on immediate stop", func(t *testing.T) {
+		slice := Unfold(0, func(s int) (int, int, bool) { return s, s, false })
+		assert.Equal(t, []int{}, slice)
 	})
 
-	t.Run("True on nil sets", func(t *testing.T) {
-		assert.True(t, IsSubSet[int](nil, nil))
+	t.Run("Panic on nil step function", func(t *testing.T) {
+		assert.Panics(t, func() { Unfold[int, int](0, nil) })
 	})
 }
 
-func TestIsSuperSet(t *testing.T) {
-	t.Run("IsSuperSet on superset", func(t *testing.T) {
-		super := []int{1, 2, 3}
-		sub := []int{1, 2}
-		assert.True(t, IsSuperSet(super, sub))
+func TestUnion(t *testing.T) {
+	t.Run("Union on two overlapping sets", func(t *testing.T) {
+		a := []int{1, 2, 3}
+		b := []int{3, 2, 6}
+		union := Union(a, b)
+		assert.Equal(t, []int{1, 2, 3, 6}, union)
 	})
 
-	t.Run("IsSuperSet on non-superset", func(t *testing.T) {
-		notSuper := []int{1, 2, 3}
-		sub := []int{1, 2, 4}
-		assert.False(t, IsSuperSet(notSuper, sub))
+	t.Run("Preserve left set on empty right set", func(t *testing.T) {
+		a := []int{1, 2, 3}
+		b := []int{}
+		union := Union(a, b)
+		assert.Equal(t, []int{1, 2, 3}, union)
 	})
 
-	t.Run("Equal set is superset of itself", func(t *testing.T) {
-		set := []int{1, 2, 3}
-		assert.True(t, IsSuperSet(set, set))
+	t.Run("Empty set on empty sets", func(t *testing.T) {
+		a := []int{}
+		b := []int{}
+		union := Union(a, b)
+		assert.Equal(t, []int{}, union)
 	})
 
-	t.Run("True on nil sets", func(t *testing.T) {
-		assert.True(t, IsSubSet[int](nil, nil))
+	t.Run("Do not modify left set with extra capacity", func(t *testing.T) {
+		a := make([]int, 3, 10)
+		copy(a, []int{1, 2, 3})
+		b := []int{4, 1, 5}
+		union := Union(a, b)
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, union)
+		assert.Equal(t, []int{1, 2, 3}, a)
+		assert.Equal(t, []int{1, 2, 3, 0, 0, 0}, a[:6])
+	})
+
+	t.Run("Return nil when both sets are nil", func(t *testing.T) {
+		union := Union[int](nil, nil)
+		assert.Nil(t, union)
 	})
 }
 
-func TestJoin(t *testing.T) {
-	t.Run("Join variadics", func(t *testing.T) {
-		slice1 := []int{1, 2, 3}
-		slice2 := []int{4, 5, 6}
-		slice3 := []int{7, 8}
+func TestUnionBy(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	id := func(u user) int { return u.id }
 
-		joined := Join(slice1, slice2, slice3)
-		assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, joined)
+	t.Run("Union of structs by ID", func(t *testing.T) {
+		a := []user{{1, "a"}, {2, "b"}}
+		b := []user{{3, "c"}, {1, "x"}}
+		assert.Equal(t, []user{{1, "a"}, {2, "b"}, {3, "c"}}, UnionBy(a, b, id))
+	})
+
+	t.Run("Keep left element on key collision", func(t *testing.T) {
+		a := []user{{1, "left"}}
+		b := []user{{1, "right"}}
+		assert.Equal(t, []user{{1, "left"}}, UnionBy(a, b, id))
+	})
+
+	t.Run("Do not modify left set", func(t *testing.T) {
+		a := make([]user, 1, 10)
+		a[0] = user{1, "a"}
+		UnionBy(a, []user{{2, "b"}}, id)
+		assert.Equal(t, user{}, a[:2][1])
+	})
+
+	t.Run("Return nil when both sets are nil", func(t *testing.T) {
+		assert.Nil(t, UnionBy(nil, nil, id))
+	})
+}
+
+func TestUnionN(t *testing.T) {
+	t.Run("Union of three overlapping sets", func(t *testing.T) {
+		a := []int{1, 2, 3}
+		b := []int{3, 4, 1}
+		c := []int{5, 4, 2}
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, UnionN(a, b, c))
+	})
+
+	t.Run("Keep first-seen order", func(t *testing.T) {
+		a := []int{3, 1}
+		b := []int{2, 3}
+		c := []int{0, 2, 1}
+		assert.Equal(t, []int{3, 1, 2, 0}, UnionN(a, b, c))
+	})
+
+	t.Run("Return deduplicated slice on single slice", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2}, UnionN([]int{1, 1, 2, 1}))
+	})
+
+	t.Run("Do not modify inputs", func(t *testing.T) {
+		a := make([]int, 2, 10)
+		a[0], a[1] = 1, 1
+		UnionN(a, []int{2})
+		assert.Equal(t, []int{1, 1}, a)
+		assert.Equal(t, []int{1, 1, 0}, a[:3])
+	})
+
+	t.Run("Return nil on no slices", func(t *testing.T) {
+		assert.Nil(t, UnionN[int]())
+	})
+
+	t.Run("Return nil when all sets are nil", func(t *testing.T) {
+		assert.Nil(t, UnionN[int](nil, nil))
+	})
+}
+
+func TestWindowReduce(t *testing.T) {
+	sum := func(window []int) int { return Fold(window, 0, func(acc, val int) int { return acc + val }) }
+
+	t.Run("Window sums", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4, 5}
+		sums := WindowReduce(slice, 3, sum)
+		assert.Equal(t, []int{6, 9, 12}, sums)
+	})
+
+	t.Run("Window maxima", func(t *testing.T) {
+		slice := []int{1, 3, 2, 5, 4}
+		maxima := WindowReduce(slice, 2, func(window []int) int {
+			max, _ := MaxBy(window, func(a, b int) bool { return a < b })
+			return max
+		})
+		assert.Equal(t, []int{3, 3, 5, 5}, maxima)
+	})
+
+	t.Run("Window of the whole slice", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		sums := WindowReduce(slice, 3, sum)
+		assert.Equal(t, []int{6}, sums)
 	})
 
-	t.Run("Join two dimensional slice", func(t *testing.T) {
-		slice := [][]int{{1, 2, 3}, {4, 5, 6}, {7, 8}}
-		joined := Join(slice...)
-		assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, joined)
+	t.Run("Return empty slice when size is larger than length", func(t *testing.T) {
+		slice := []int{1, 2}
+		sums := WindowReduce(slice, 3, sum)
+		assert.Equal(t, []int{}, sums)
 	})
 
-	t.Run("Return empty slice on nil slices", func(t *testing.T) {
+	t.Run("Return nil on nil slice", func(t *testing.T) {
 		var slice []int = nil
-		var slice2 []int = nil
-		joined := Join(slice, slice2)
-		assert.Equal(t, []int{}, joined)
+		sums := WindowReduce(slice, 2, sum)
+		assert.Nil(t, sums)
 	})
 
-	t.Run("Return nil on no arguments", func(t *testing.T) {
-		joined := Join[int]()
-		assert.Nil(t, joined)
+	t.Run("Panic on non-positive size", func(t *testing.T) {
+		assert.Panics(t, func() { WindowReduce([]int{1}, 0, sum) })
 	})
 }
 
-func TestMap(t *testing.T) {
-	t.Run("Map strings to their byte lengths", func(t *testing.T) {
-		slice := []string{"bar", "", "f", "hello", "world"}
-		lengths := Map(slice, func(s string) int { return len(s) })
-		assert.Equal(t, []int{3, 0, 1, 5, 5}, lengths)
+func TestWindows(t *testing.T) {
+	t.Run("Windows of size two", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4}
+		windows := Windows(slice, 2)
+		assert.Equal(t, [][]int{{1, 2}, {2, 3}, {3, 4}}, windows)
 	})
 
-	t.Run("Return nil on nil slice", func(t *testing.T) {
-		var slice []string = nil
-		outSlice := Map(slice, func(s string) int { return len(s) })
-		assert.Nil(t, outSlice)
+	t.Run("Windows of size one", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		windows := Windows(slice, 1)
+		assert.Equal(t, [][]int{{1}, {2}, {3}}, windows)
 	})
-}
 
-func TestMapInPlace(t *testing.T) {
-	t.Run("Integers incremented", func(t *testing.T) {
+	t.Run("Window of size equal to length", func(t *testing.T) {
 		slice := []int{1, 2, 3}
-		MapInPlace(slice, func(i int) int { return i + 1 })
-		assert.Equal(t, []int{2, 3, 4}, slice)
+		windows := Windows(slice, 3)
+		assert.Equal(t, [][]int{{1, 2, 3}}, windows)
 	})
 
-	t.Run("Strings capitalized", func(t *testing.T) {
-		slice := []string{"bar", "", "f", "hello", "world"}
-		MapInPlace(slice, func(s string) string { return strings.ToUpper(s) })
-		assert.Equal(t, []string{"BAR", "", "F", "HELLO", "WORLD"}, slice)
+	t.Run("Return empty slice when size is larger than length", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		windows := Windows(slice, 4)
+		assert.Equal(t, [][]int{}, windows)
 	})
 
-	t.Run("Do nothing on nil slice", func(t *testing.T) {
-		var slice []int = nil
-		MapInPlace(slice, func(i int) int { return i })
-		assert.Nil(t, slice)
+	t.Run("Windows share backing array", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		windows := Windows(slice, 2)
+		windows[0][1] = 9
+		assert.Equal(t, []int{1, 9, 3}, slice)
+		assert.Equal(t, []int{9, 3}, windows[1])
 	})
-}
 
-func TestMaxBy(t *testing.T) {
-	t.Run("Return max from slice", func(t *testing.T) {
-		slice := []int{4, 5, 7, 3, 9, -1, 3, 4, 7, 12, 43, 10, 5}
-		max, ok := MaxBy(slice, func(lhs, rhs int) bool {
-			return lhs < rhs
-		})
-		assert.True(t, ok)
-		assert.Equal(t, 43, max)
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		windows := Windows(slice, 2)
+		assert.Nil(t, windows)
 	})
 
-	t.Run("Return zero value and false on empty slice", func(t *testing.T) {
-		slice := []int{}
-		max, ok := MaxBy(slice, func(lhs, rhs int) bool {
-			return lhs < rhs
-		})
-		assert.False(t, ok)
-		assert.Zero(t, max)
+	t.Run("Panic on non-positive size", func(t *testing.T) {
+		assert.Panics(t, func() { Windows([]int{1}, 0) })
 	})
 }
 
-func TestMinBy(t *testing.T) {
-	t.Run("Return min from slice", func(t *testing.T) {
-		slice := []int{4, 5, 7, 3, 9, -1, 3, 4, 7, 12, 43, 10, 5}
-		min, ok := MinBy(slice, func(lhs, rhs int) bool {
-			return lhs < rhs
-		})
-		assert.True(t, ok)
-		assert.Equal(t, -1, min)
+func TestZipLongest(t *testing.T) {
+	t.Run("Pad shorter right slice", func(t *testing.T) {
+		a := []int{1, 2, 3}
+		b := []string{"a"}
+		zipped := ZipLongest(a, b, 0, "-")
+		assert.Equal(t, []Pair[int, string]{
+			{First: 1, Second: "a"},
+			{First: 2, Second: "-"},
+			{First: 3, Second: "-"},
+		}, zipped)
 	})
 
-	t.Run("Return zero value and false on empty slice", func(t *testing.T) {
-		slice := []int{}
-		min, ok := MinBy(slice, func(lhs, rhs int) bool {
-			return lhs < rhs
-		})
-		assert.False(t, ok)
-		assert.Zero(t, min)
+	t.Run("Pad shorter left slice", func(t *testing.T) {
+		a := []int{1}
+		b := []string{"a", "b"}
+		zipped := ZipLongest(a, b, -1, "")
+		assert.Equal(t, []Pair[int, string]{
+			{First: 1, Second: "a"},
+			{First: -1, Second: "b"},
+		}, zipped)
 	})
-}
 
-func TestPartition(t *testing.T) {
-	t.Run("Partition by integer parity", func(t *testing.T) {
-		slice := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
-		even, odd := Partition(slice, func(i int) bool { return i%2 == 0 })
-		assert.Equal(t, []int{2, 4, 6, 8, 10}, even)
-		assert.Equal(t, []int{1, 3, 5, 7, 9}, odd)
+	t.Run("Pad nil slice", func(t *testing.T) {
+		zipped := ZipLongest(nil, []int{1}, 0, 0)
+		assert.Equal(t, []Pair[int, int]{{First: 0, Second: 1}}, zipped)
 	})
 
-	t.Run("Return nil on nil slice", func(t *testing.T) {
-		var slice []int = nil
-		even, odd := Partition(slice, func(i int) bool { return i%2 == 0 })
-		assert.Nil(t, even)
-		assert.Nil(t, odd)
+	t.Run("Return nil when both slices are nil", func(t *testing.T) {
+		zipped := ZipLongest[int, int](nil, nil, 0, 0)
+		assert.Nil(t, zipped)
 	})
 }
 
-func TestPartitionInPlace(t *testing.T) {
-	t.Run("Partition with even number of elements", func(t *testing.T) {
-		slice := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
-		idx := PartitionInPlace(slice, func(i int) bool { return i%2 == 0 })
-		assert.Equal(t, []int{10, 2, 8, 4, 6}, slice[:idx])
-		assert.Equal(t, []int{5, 7, 3, 9, 1}, slice[idx:])
-	})
+func TestZipWith(t *testing.T) {
+	add := func(a, b int) int { return a + b }
 
-	t.Run("Partition with odd number of elements", func(t *testing.T) {
-		slice := []int{1, 3, 4, -1, -5, 10, 9, -4, -3}
-		idx := PartitionInPlace(slice, func(i int) bool { return i > 0 })
-		assert.Equal(t, []int{1, 3, 4, 9, 10}, slice[:idx])
-		assert.Equal(t, []int{-5, -1, -4, -3}, slice[idx:])
+	t.Run("Add two vectors", func(t *testing.T) {
+		a := []int{1, 2, 3}
+		b := []int{10, 20, 30}
+		sums := ZipWith(a, b, add)
+		assert.Equal(t, []int{11, 22, 33}, sums)
 	})
 
-	t.Run("Partition with single element", func(t *testing.T) {
-		slice := []int{1}
-		idx := PartitionInPlace(slice, func(i int) bool { return i > 0 })
-		assert.Equal(t, []int{1}, slice[:idx])
-		assert.Equal(t, []int{}, slice[idx:])
+	t.Run("Stop at the shorter slice", func(t *testing.T) {
+		a := []string{"a", "b", "c"}
+		b := []int{1, 2}
+		repeated := ZipWith(a, b, strings.Repeat)
+		assert.Equal(t, []string{"a", "bb"}, repeated)
+
+		sums := ZipWith([]int{1}, []int{1, 2, 3}, add)
+		assert.Equal(t, []int{2}, sums)
 	})
 
-	t.Run("Do nothing on empty slice and return zero index", func(t *testing.T) {
-		slice := []int{}
-		idx := PartitionInPlace(slice, func(i int) bool { return i%2 == 0 })
-		assert.Equal(t, 0, idx)
+	t.Run("Return empty slice on empty slice", func(t *testing.T) {
+		sums := ZipWith([]int{}, []int{1}, add)
+		assert.Equal(t, []int{}, sums)
 	})
 
-	t.Run("Do nothing on nil slice and return zero index", func(t *testing.T) {
-		var slice []int = nil
-		idx := PartitionInPlace(slice, func(i int) bool { return i%2 == 0 })
-		assert.Equal(t, 0, idx)
+	t.Run("Return nil when either slice is nil", func(t *testing.T) {
+		assert.Nil(t, ZipWith(nil, []int{1}, add))
+		assert.Nil(t, ZipWith([]int{1}, nil, add))
 	})
 }
 
-func TestReverse(t *testing.T) {
-	t.Run("Reverse integer slice", func(t *testing.T) {
+////////////////////////
+// PARALLEL FUNCTIONS //
+////////////////////////
+
+func TestParChunkMap(t *testing.T) {
+	t.Run("Map chunks in order", func(t *testing.T) {
+		slice := Generate(1000, func(idx int) int { return idx })
+		doubled := ParChunkMap(slice, 7, func(chunk []int) []int {
+			return Map(chunk, func(val int) int { return val * 2 })
+		})
+		assert.Equal(t, Generate(1000, func(idx int) int { return idx * 2 }), doubled)
+	})
+
+	t.Run("Chunks have expected sizes", func(t *testing.T) {
 		slice := []int{1, 2, 3, 4, 5}
-		reversed := Reverse(slice)
-		assert.Equal(t, []int{5, 4, 3, 2, 1}, reversed)
+		sizes := ParChunkMap(slice, 2, func(chunk []int) []int { return []int{len(chunk)} })
+		assert.Equal(t, []int{2, 2, 1}, sizes)
+	})
+
+	t.Run("Chunk larger than slice", func(t *testing.T) {
+		slice := []string{"a", "b"}
+		joined := ParChunkMap(slice, 10, func(chunk []string) []string {
+			return []string{strings.Join(chunk, "")}
+		})
+		assert.Equal(t, []string{"ab"}, joined)
+	})
+
+	t.Run("Return empty slice on empty slice", func(t *testing.T) {
+		mapped := ParChunkMap([]int{}, 2, func(chunk []int) []int { return chunk })
+		assert.Equal(t, []int{}, mapped)
 	})
 
 	t.Run("Return nil on nil slice", func(t *testing.T) {
 		var slice []int = nil
-		output := Reverse(slice)
-		assert.Nil(t, output)
+		mapped := ParChunkMap(slice, 2, func(chunk []int) []int { return chunk })
+		assert.Nil(t, mapped)
+	})
+
+	t.Run("Panic on non-positive chunk size", func(t *testing.T) {
+		assert.Panics(t, func() {
+			ParChunkMap([]int{1}, 0, func(chunk []int) []int { return chunk })
+		})
 	})
 }
 
-func TestReverseInPlace(t *testing.T) {
-	t.Run("Reverse integer slice", func(t *testing.T) {
-		slice := []int{1, 2, 3, 4, 5}
-		ReverseInPlace(slice)
-		assert.Equal(t, []int{5, 4, 3, 2, 1}, slice)
+func TestParChunkMapWith(t *testing.T) {
+	sum := func(chunk []int) []int { return []int{Fold(chunk, 0, func(acc, val int) int { return acc + val })} }
+
+	t.Run("Sum chunks with various worker counts", func(t *testing.T) {
+		slice := Generate(100, func(idx int) int { return idx })
+		for _, workers := range []int{-1, 0, 1, 3, 100} {
+			mapped := ParChunkMapWith(slice, 7, sum, WithWorkers(workers))
+			assert.Equal(t, ParChunkMap(slice, 7, sum), mapped, "workers %d", workers)
+		}
 	})
 
-	t.Run("Do nothing on nil slice", func(t *testing.T) {
+	t.Run("Do not exceed number of workers", func(t *testing.T) {
+		var active, maxActive int32
+		slice := Generate(64, func(idx int) int { return idx })
+		ParChunkMapWith(slice, 4, func(chunk []int) []int {
+			current := atomic.AddInt32(&active, 1)
+			for {
+				observed := atomic.LoadInt32(&maxActive)
+				if current <= observed || atomic.CompareAndSwapInt32(&maxActive, observed, current) {
+					break
+				}
+			}
+			runtime.Gosched()
+			atomic.AddInt32(&active, -1)
+			return chunk
+		}, WithWorkers(2))
+		assert.LessOrEqual(t, atomic.LoadInt32(&maxActive), int32(2))
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
 		var slice []int = nil
-		ReverseInPlace(slice)
-		assert.Nil(t, slice)
+		assert.Nil(t, ParChunkMapWith(slice, 2, sum, WithWorkers(2)))
 	})
 }
 
-func TestSymmetricDifference(t *testing.T) {
-	t.Run("Symmetric difference on two overlapping sets", func(t *testing.T) {
-		a := []int{1, 2, 3}
-		b := []int{3, 2, 6}
-		symmDiff := SymmetricDifference(a, b)
-		assert.Equal(t, []int{1, 6}, symmDiff)
+func TestParFilter(t *testing.T) {
+	t.Run("Retain even values in large array in order", func(t *testing.T) {
+		slice := Generate(1000, func(idx int) int { return idx })
+		isEven := func(val int) bool { return val%2 == 0 }
+		assert.Equal(t, Filter(slice, isEven), ParFilter(slice, isEven))
 	})
 
-	t.Run("Preserve left set on empty right set", func(t *testing.T) {
-		a := []int{1, 2, 3}
-		b := []int{}
-		symmDiff := SymmetricDifference(a, b)
-		assert.Equal(t, []int{1, 2, 3}, symmDiff)
+	t.Run("Match sequential Filter for various lengths", func(t *testing.T) {
+		for _, length := range []int{0, 1, 2, 7, 97} {
+			slice := Generate(length, func(idx int) int { return idx })
+			filterFn := func(val int) bool { return val%3 != 1 }
+			assert.Equal(t, Filter(slice, filterFn), ParFilter(slice, filterFn), "length %d", length)
+		}
 	})
 
-	t.Run("Empty set on empty sets", func(t *testing.T) {
-		a := []int{}
-		b := []int{}
-		symmDiff := SymmetricDifference(a, b)
-		assert.Equal(t, []int{}, symmDiff)
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		outSlice := ParFilter(slice, func(val int) bool { return true })
+		assert.Nil(t, outSlice)
 	})
+}
 
-	t.Run("Return nil when both sets are nil", func(t *testing.T) {
-		symmDiff := SymmetricDifference[int](nil, nil)
-		assert.Nil(t, symmDiff)
-	})
+// Deliberately expensive predicate for benchmarking parallel functions.
+func slowIsEven(val int) bool {
+	h := fnv.New64a()
+	for i := 0; i < 1000; i++ {
+		h.Write([]byte{byte(val), byte(i)})
+	}
+	return h.Sum64() != 0 && val%2 == 0
 }
 
-func TestUnion(t *testing.T) {
-	t.Run("Union on two overlapping sets", func(t *testing.T) {
-		a := []int{1, 2, 3}
-		b := []int{3, 2, 6}
-		union := Union(a, b)
-		assert.Equal(t, []int{1, 2, 3, 6}, union)
+func BenchmarkParFilter(b *testing.B) {
+	slice := Generate(1000, func(idx int) int { return idx })
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ParFilter(slice, slowIsEven)
+	}
+}
+
+func BenchmarkFilterSlowPredicate(b *testing.B) {
+	slice := Generate(1000, func(idx int) int { return idx })
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Filter(slice, slowIsEven)
+	}
+}
+
+func TestParFilterWith(t *testing.T) {
+	t.Run("Retain even values with various worker counts", func(t *testing.T) {
+		slice := Generate(100, func(idx int) int { return idx })
+		isEven := func(val int) bool { return val%2 == 0 }
+		for _, workers := range []int{-1, 0, 1, 3, 100, 1000} {
+			filtered := ParFilterWith(slice, isEven, WithWorkers(workers))
+			assert.Equal(t, Filter(slice, isEven), filtered, "workers %d", workers)
+		}
 	})
 
-	t.Run("Preserve left set on empty right set", func(t *testing.T) {
-		a := []int{1, 2, 3}
-		b := []int{}
-		union := Union(a, b)
-		assert.Equal(t, []int{1, 2, 3}, union)
+	t.Run("More workers than elements", func(t *testing.T) {
+		slice := []int{1, 2}
+		filtered := ParFilterWith(slice, func(val int) bool { return val > 1 }, WithWorkers(8))
+		assert.Equal(t, []int{2}, filtered)
 	})
 
-	t.Run("Empty set on empty sets", func(t *testing.T) {
-		a := []int{}
-		b := []int{}
-		union := Union(a, b)
-		assert.Equal(t, []int{}, union)
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		outSlice := ParFilterWith(slice, func(val int) bool { return true }, WithWorkers(2))
+		assert.Nil(t, outSlice)
 	})
 
-	t.Run("Return nil when both sets are nil", func(t *testing.T) {
-		union := Union[int](nil, nil)
-		assert.Nil(t, union)
+	t.Run("Limit workers to slice length", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		// Starting a goroutine per requested worker would allocate for each.
+		allocs := testing.AllocsPerRun(1, func() {
+			ParFilterWith(slice, func(i int) bool { return true }, WithWorkers(1_000_000))
+		})
+		assert.Less(t, allocs, 100.0)
 	})
 }
 
-////////////////////////
-// PARALLEL FUNCTIONS //
-////////////////////////
-
 func TestParMap(t *testing.T) {
 	t.Run("Increment int values by one in large array", func(t *testing.T) {
 		slice := Generate(1000, func(idx int) int { return idx })
@@ -737,9 +4358,143 @@ func TestParMap(t *testing.T) {
 		assert.Equal(t, []int{3, 0, 1, 5, 5}, lengths)
 	})
 
+	t.Run("Match sequential Map for various lengths", func(t *testing.T) {
+		// Prime lengths do not divide evenly between the goroutines.
+		for _, length := range []int{0, 1, 2, 7, 97, 997} {
+			slice := Generate(length, func(idx int) int { return idx })
+			mapFn := func(val int) string { return strconv.Itoa(val * val) }
+			assert.Equal(t, Map(slice, mapFn), ParMap(slice, mapFn), "length %d", length)
+		}
+	})
+
 	t.Run("Return nil on nil slice", func(t *testing.T) {
 		var slice []string = nil
 		outSlice := ParMap(slice, func(s string) int { return len(s) })
 		assert.Nil(t, outSlice)
 	})
 }
+
+func TestParMapErr(t *testing.T) {
+	t.Run("Parse all strings successfully", func(t *testing.T) {
+		slice := Generate(100, func(idx int) string { return strconv.Itoa(idx) })
+		ints, err := ParMapErr(context.Background(), slice, func(ctx context.Context, s string) (int, error) {
+			return strconv.Atoi(s)
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, Generate(100, func(idx int) int { return idx }), ints)
+	})
+
+	t.Run("Cancel other mappings on first error", func(t *testing.T) {
+		errFoo := errors.New("foo")
+		slice := Generate(1000, func(idx int) int { return idx })
+		var calls int32
+		ints, err := ParMapErr(context.Background(), slice, func(ctx context.Context, val int) (int, error) {
+			atomic.AddInt32(&calls, 1)
+			if val == 0 {
+				return 0, errFoo
+			}
+			// Block until cancelled; would never return without cancellation.
+			<-ctx.Done()
+			return 0, ctx.Err()
+		})
+		assert.Equal(t, errFoo, err)
+		assert.Nil(t, ints)
+		assert.Less(t, int(atomic.LoadInt32(&calls)), len(slice))
+	})
+
+	t.Run("Return context error when cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		ints, err := ParMapErr(ctx, []int{1, 2, 3}, func(ctx context.Context, val int) (int, error) {
+			return val, nil
+		})
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Nil(t, ints)
+	})
+
+	t.Run("Return empty slice on empty slice", func(t *testing.T) {
+		ints, err := ParMapErr(context.Background(), []int{}, func(ctx context.Context, val int) (int, error) {
+			return val, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []int{}, ints)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []int = nil
+		ints, err := ParMapErr(context.Background(), slice, func(ctx context.Context, val int) (int, error) {
+			return val, nil
+		})
+		assert.NoError(t, err)
+		assert.Nil(t, ints)
+	})
+
+	t.Run("Do not exceed number of workers", func(t *testing.T) {
+		var active, maxActive int32
+		slice := Generate(64, func(idx int) int { return idx })
+		ints, err := ParMapErr(context.Background(), slice, func(ctx context.Context, val int) (int, error) {
+			current := atomic.AddInt32(&active, 1)
+			for {
+				observed := atomic.LoadInt32(&maxActive)
+				if current <= observed || atomic.CompareAndSwapInt32(&maxActive, observed, current) {
+					break
+				}
+			}
+			runtime.Gosched()
+			atomic.AddInt32(&active, -1)
+			return val, nil
+		}, WithWorkers(2))
+		assert.NoError(t, err)
+		assert.Equal(t, slice, ints)
+		assert.LessOrEqual(t, atomic.LoadInt32(&maxActive), int32(2))
+	})
+
+	t.Run("Limit workers to slice length", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		// Starting a goroutine per requested worker would allocate for each.
+		allocs := testing.AllocsPerRun(1, func() {
+			ParMapErr(context.Background(), slice, func(ctx context.Context, val int) (int, error) {
+				return val, nil
+			}, WithWorkers(1_000_000))
+		})
+		assert.Less(t, allocs, 100.0)
+	})
+}
+
+func TestParMapWith(t *testing.T) {
+	t.Run("Increment values with various worker counts", func(t *testing.T) {
+		slice := Generate(100, func(idx int) int { return idx })
+		increment := func(val int) int { return val + 1 }
+		for _, workers := range []int{-1, 0, 1, 3, 100, 1000} {
+			mapped := ParMapWith(slice, increment, WithWorkers(workers))
+			assert.Equal(t, Map(slice, increment), mapped, "workers %d", workers)
+		}
+	})
+
+	t.Run("More workers than elements", func(t *testing.T) {
+		slice := []string{"foo", "hello"}
+		lengths := ParMapWith(slice, func(s string) int { return len(s) }, WithWorkers(8))
+		assert.Equal(t, []int{3, 5}, lengths)
+	})
+
+	t.Run("Default to number of logical processors without options", func(t *testing.T) {
+		slice := []string{"bar", "", "f"}
+		lengths := ParMapWith(slice, func(s string) int { return len(s) })
+		assert.Equal(t, []int{3, 0, 1}, lengths)
+	})
+
+	t.Run("Return nil on nil slice", func(t *testing.T) {
+		var slice []string = nil
+		outSlice := ParMapWith(slice, func(s string) int { return len(s) }, WithWorkers(2))
+		assert.Nil(t, outSlice)
+	})
+
+	t.Run("Limit workers to slice length", func(t *testing.T) {
+		slice := []int{1, 2, 3}
+		// Starting a goroutine per requested worker would allocate for each.
+		allocs := testing.AllocsPerRun(1, func() {
+			ParMapWith(slice, func(i int) int { return i }, WithWorkers(1_000_000))
+		})
+		assert.Less(t, allocs, 100.0)
+	})
+}